@@ -24,6 +24,9 @@ func defaultCacheBehaviorConf() map[string]interface{} {
 		"cached_methods":              cachedMethodsConf(),
 		"compress":                    true,
 		"field_level_encryption_id":   "",
+		"cache_policy_id":             "",
+		"origin_request_policy_id":    "",
+		"realtime_log_config_arn":     "",
 	}
 }
 
@@ -309,6 +312,29 @@ func TestCloudFrontStructure_expandCloudFrontDefaultCacheBehavior(t *testing.T)
 	}
 }
 
+func TestCloudFrontStructure_expandCloudFrontDefaultCacheBehavior_cachePolicy(t *testing.T) {
+	data := defaultCacheBehaviorConf()
+	data["cache_policy_id"] = "658327ea-f89d-4fab-a63d-7e88639e58f6"
+	data["origin_request_policy_id"] = "216adef6-5c7f-47e4-b989-5492eafa07d3"
+	data["forwarded_values"] = []interface{}{}
+	dcb := expandCloudFrontDefaultCacheBehavior(data)
+	if dcb == nil {
+		t.Fatalf("ExpandDefaultCacheBehavior returned nil")
+	}
+	if aws.StringValue(dcb.CachePolicyId) != "658327ea-f89d-4fab-a63d-7e88639e58f6" {
+		t.Fatalf("Expected CachePolicyId to be 658327ea-f89d-4fab-a63d-7e88639e58f6, got %v", aws.StringValue(dcb.CachePolicyId))
+	}
+	if aws.StringValue(dcb.OriginRequestPolicyId) != "216adef6-5c7f-47e4-b989-5492eafa07d3" {
+		t.Fatalf("Expected OriginRequestPolicyId to be 216adef6-5c7f-47e4-b989-5492eafa07d3, got %v", aws.StringValue(dcb.OriginRequestPolicyId))
+	}
+	if dcb.ForwardedValues != nil {
+		t.Fatalf("Expected ForwardedValues to be nil, got %v", dcb.ForwardedValues)
+	}
+	if dcb.DefaultTTL != nil || dcb.MaxTTL != nil || dcb.MinTTL != nil {
+		t.Fatalf("Expected TTLs to be nil, got %v, %v, %v", dcb.DefaultTTL, dcb.MaxTTL, dcb.MinTTL)
+	}
+}
+
 func TestCloudFrontStructure_expandTrustedSigners(t *testing.T) {
 	data := trustedSignersConf()
 	ts := expandTrustedSigners(data)
@@ -1,14 +1,26 @@
 package aws
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"io/ioutil"
 	"log"
+	"net/http"
+	"net/url"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/ec2rolecreds"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/ec2metadata"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/accessanalyzer"
 	"github.com/aws/aws-sdk-go/service/acm"
 	"github.com/aws/aws-sdk-go/service/acmpca"
@@ -156,7 +168,9 @@ import (
 	"github.com/aws/aws-sdk-go/service/workspaces"
 	"github.com/aws/aws-sdk-go/service/xray"
 	awsbase "github.com/hashicorp/aws-sdk-go-base"
+	"github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
+	homedir "github.com/mitchellh/go-homedir"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
@@ -169,6 +183,9 @@ type Config struct {
 	Region        string
 	MaxRetries    int
 
+	// Retries contains the retry settings for service endpoint names.
+	Retries map[string]*RetryConfig
+
 	AssumeRoleARN               string
 	AssumeRoleDurationSeconds   int
 	AssumeRoleExternalID        string
@@ -178,12 +195,29 @@ type Config struct {
 	AssumeRoleTags              map[string]string
 	AssumeRoleTransitiveTagKeys []string
 
+	// AssumeRoleChain contains IAM Roles to assume in order, each using the
+	// credentials of the previous one, before assuming the AssumeRoleARN role.
+	AssumeRoleChain []*AssumeRole
+
+	AssumeRoleWithWebIdentityDuration    time.Duration
+	AssumeRoleWithWebIdentityRoleARN     string
+	AssumeRoleWithWebIdentitySessionName string
+	AssumeRoleWithWebIdentityToken       string
+	AssumeRoleWithWebIdentityTokenFile   string
+
 	AllowedAccountIds   []string
 	ForbiddenAccountIds []string
 
-	Endpoints        map[string]string
-	IgnoreTagsConfig *keyvaluetags.IgnoreConfig
-	Insecure         bool
+	DefaultTagsConfig *keyvaluetags.DefaultConfig
+	Endpoints         map[string]string
+	IgnoreTagsConfig  *keyvaluetags.IgnoreConfig
+	Insecure          bool
+
+	CustomCABundle             string
+	EC2MetadataServiceEndpoint string
+	HTTPProxy                  string
+	UseDualStackEndpoint       bool
+	UseFIPSEndpoint            bool
 
 	SkipCredsValidation     bool
 	SkipGetEC2Platforms     bool
@@ -195,6 +229,26 @@ type Config struct {
 	terraformVersion string
 }
 
+// AssumeRole contains the settings to assume a single IAM Role.
+type AssumeRole struct {
+	DurationSeconds   int
+	ExternalID        string
+	Policy            string
+	PolicyARNs        []string
+	RoleARN           string
+	SessionName       string
+	Tags              map[string]string
+	TransitiveTagKeys []string
+}
+
+// RetryConfig contains the retry settings for a service.
+type RetryConfig struct {
+	BaseDelay           time.Duration
+	MaxAttempts         int
+	MaxDelay            time.Duration
+	RetryableErrorCodes []string
+}
+
 type AWSClient struct {
 	accessanalyzerconn                  *accessanalyzer.AccessAnalyzer
 	accountid                           string
@@ -237,6 +291,7 @@ type AWSClient struct {
 	datapipelineconn                    *datapipeline.DataPipeline
 	datasyncconn                        *datasync.DataSync
 	daxconn                             *dax.DAX
+	DefaultTagsConfig                   *keyvaluetags.DefaultConfig
 	devicefarmconn                      *devicefarm.DeviceFarm
 	dlmconn                             *dlm.DLM
 	dmsconn                             *databasemigrationservice.DatabaseMigrationService
@@ -410,11 +465,70 @@ func (c *Config) Client() (interface{}, error) {
 		},
 	}
 
+	var chainedCreds *credentials.Credentials
+
+	customSession := c.HTTPProxy != "" || c.CustomCABundle != "" || c.UseDualStackEndpoint || c.UseFIPSEndpoint
+
+	if customSession {
+		// awsbase uses its own HTTP client and endpoints to validate the
+		// credentials and request the account ID, so these are handled
+		// below instead.
+		awsbaseConfig.SkipCredsValidation = true
+		awsbaseConfig.SkipRequestingAccountId = true
+	}
+
+	if c.AssumeRoleWithWebIdentityRoleARN != "" || len(c.AssumeRoleChain) > 0 || customSession || c.EC2MetadataServiceEndpoint != "" {
+		creds, err := c.chainedCredentials(awsbaseConfig)
+
+		if err != nil {
+			return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
+		}
+
+		value, err := creds.Get()
+
+		if err != nil {
+			return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
+		}
+
+		// awsbase supports neither web identity federation nor assuming more
+		// than one role, so the resulting credentials take precedence over
+		// any other credential sources and the roles are not assumed again.
+		awsbaseConfig.AccessKey = value.AccessKeyID
+		awsbaseConfig.AssumeRoleARN = ""
+		awsbaseConfig.SecretKey = value.SecretAccessKey
+		awsbaseConfig.Token = value.SessionToken
+		chainedCreds = creds
+	}
+
 	sess, accountID, partition, err := awsbase.GetSessionWithAccountIDAndPartition(awsbaseConfig)
 	if err != nil {
 		return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
 	}
 
+	// Replace the static credentials handed to awsbase with the refreshable
+	// chained credentials.
+	if chainedCreds != nil {
+		sess.Config.Credentials = chainedCreds
+	}
+
+	if customSession {
+		httpClient, err := c.httpClient()
+
+		if err != nil {
+			return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
+		}
+
+		sess.Config.EndpointResolver = c.endpointResolver(sess.Config.EndpointResolver)
+		sess.Config.HTTPClient = httpClient
+		sess.Config.UseDualStack = aws.Bool(c.UseDualStackEndpoint)
+
+		accountID, partition, err = c.accountIDAndPartition(sess)
+
+		if err != nil {
+			return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
+		}
+	}
+
 	if accountID == "" {
 		log.Printf("[WARN] AWS account ID not found for provider. See https://www.terraform.io/docs/providers/aws/index.html#skip_requesting_account_id for implications.")
 	}
@@ -429,172 +543,165 @@ func (c *Config) Client() (interface{}, error) {
 	}
 
 	client := &AWSClient{
-		accessanalyzerconn:                  accessanalyzer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["accessanalyzer"])})),
+		accessanalyzerconn:                  accessanalyzer.New(sess.Copy(c.serviceConfig("accessanalyzer"))),
 		accountid:                           accountID,
-		acmconn:                             acm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["acm"])})),
-		acmpcaconn:                          acmpca.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["acmpca"])})),
-		amplifyconn:                         amplify.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["amplify"])})),
-		apigatewayconn:                      apigateway.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["apigateway"])})),
-		apigatewayv2conn:                    apigatewayv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["apigateway"])})),
-		appautoscalingconn:                  applicationautoscaling.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["applicationautoscaling"])})),
-		applicationinsightsconn:             applicationinsights.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["applicationinsights"])})),
-		appmeshconn:                         appmesh.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["appmesh"])})),
-		appstreamconn:                       appstream.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["appstream"])})),
-		appsyncconn:                         appsync.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["appsync"])})),
-		athenaconn:                          athena.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["athena"])})),
-		autoscalingconn:                     autoscaling.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["autoscaling"])})),
-		autoscalingplansconn:                autoscalingplans.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["autoscalingplans"])})),
-		backupconn:                          backup.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["backup"])})),
-		batchconn:                           batch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["batch"])})),
-		budgetconn:                          budgets.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["budgets"])})),
-		cfconn:                              cloudformation.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cloudformation"])})),
-		cloud9conn:                          cloud9.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cloud9"])})),
-		cloudfrontconn:                      cloudfront.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cloudfront"])})),
-		cloudhsmv2conn:                      cloudhsmv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cloudhsm"])})),
-		cloudsearchconn:                     cloudsearch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cloudsearch"])})),
-		cloudtrailconn:                      cloudtrail.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cloudtrail"])})),
-		cloudwatchconn:                      cloudwatch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cloudwatch"])})),
-		cloudwatcheventsconn:                cloudwatchevents.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cloudwatchevents"])})),
-		cloudwatchlogsconn:                  cloudwatchlogs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cloudwatchlogs"])})),
-		codeartifactconn:                    codeartifact.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["codeartifact"])})),
-		codebuildconn:                       codebuild.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["codebuild"])})),
-		codecommitconn:                      codecommit.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["codecommit"])})),
-		codedeployconn:                      codedeploy.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["codedeploy"])})),
-		codepipelineconn:                    codepipeline.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["codepipeline"])})),
-		codestarnotificationsconn:           codestarnotifications.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["codestarnotifications"])})),
-		cognitoconn:                         cognitoidentity.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cognitoidentity"])})),
-		cognitoidpconn:                      cognitoidentityprovider.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cognitoidp"])})),
-		configconn:                          configservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["configservice"])})),
-		costandusagereportconn:              costandusagereportservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cur"])})),
-		dataexchangeconn:                    dataexchange.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["dataexchange"])})),
-		datapipelineconn:                    datapipeline.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["datapipeline"])})),
-		datasyncconn:                        datasync.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["datasync"])})),
-		daxconn:                             dax.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["dax"])})),
-		devicefarmconn:                      devicefarm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["devicefarm"])})),
-		dlmconn:                             dlm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["dlm"])})),
-		dmsconn:                             databasemigrationservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["dms"])})),
+		acmconn:                             acm.New(sess.Copy(c.serviceConfig("acm"))),
+		acmpcaconn:                          acmpca.New(sess.Copy(c.serviceConfig("acmpca"))),
+		amplifyconn:                         amplify.New(sess.Copy(c.serviceConfig("amplify"))),
+		apigatewayconn:                      apigateway.New(sess.Copy(c.serviceConfig("apigateway"))),
+		apigatewayv2conn:                    apigatewayv2.New(sess.Copy(c.serviceConfig("apigateway"))),
+		appautoscalingconn:                  applicationautoscaling.New(sess.Copy(c.serviceConfig("applicationautoscaling"))),
+		applicationinsightsconn:             applicationinsights.New(sess.Copy(c.serviceConfig("applicationinsights"))),
+		appmeshconn:                         appmesh.New(sess.Copy(c.serviceConfig("appmesh"))),
+		appstreamconn:                       appstream.New(sess.Copy(c.serviceConfig("appstream"))),
+		appsyncconn:                         appsync.New(sess.Copy(c.serviceConfig("appsync"))),
+		athenaconn:                          athena.New(sess.Copy(c.serviceConfig("athena"))),
+		autoscalingconn:                     autoscaling.New(sess.Copy(c.serviceConfig("autoscaling"))),
+		autoscalingplansconn:                autoscalingplans.New(sess.Copy(c.serviceConfig("autoscalingplans"))),
+		backupconn:                          backup.New(sess.Copy(c.serviceConfig("backup"))),
+		batchconn:                           batch.New(sess.Copy(c.serviceConfig("batch"))),
+		budgetconn:                          budgets.New(sess.Copy(c.serviceConfig("budgets"))),
+		cfconn:                              cloudformation.New(sess.Copy(c.serviceConfig("cloudformation"))),
+		cloud9conn:                          cloud9.New(sess.Copy(c.serviceConfig("cloud9"))),
+		cloudfrontconn:                      cloudfront.New(sess.Copy(c.serviceConfig("cloudfront"))),
+		cloudhsmv2conn:                      cloudhsmv2.New(sess.Copy(c.serviceConfig("cloudhsm"))),
+		cloudsearchconn:                     cloudsearch.New(sess.Copy(c.serviceConfig("cloudsearch"))),
+		cloudtrailconn:                      cloudtrail.New(sess.Copy(c.serviceConfig("cloudtrail"))),
+		cloudwatchconn:                      cloudwatch.New(sess.Copy(c.serviceConfig("cloudwatch"))),
+		cloudwatcheventsconn:                cloudwatchevents.New(sess.Copy(c.serviceConfig("cloudwatchevents"))),
+		cloudwatchlogsconn:                  cloudwatchlogs.New(sess.Copy(c.serviceConfig("cloudwatchlogs"))),
+		codeartifactconn:                    codeartifact.New(sess.Copy(c.serviceConfig("codeartifact"))),
+		codebuildconn:                       codebuild.New(sess.Copy(c.serviceConfig("codebuild"))),
+		codecommitconn:                      codecommit.New(sess.Copy(c.serviceConfig("codecommit"))),
+		codedeployconn:                      codedeploy.New(sess.Copy(c.serviceConfig("codedeploy"))),
+		codepipelineconn:                    codepipeline.New(sess.Copy(c.serviceConfig("codepipeline"))),
+		codestarnotificationsconn:           codestarnotifications.New(sess.Copy(c.serviceConfig("codestarnotifications"))),
+		cognitoconn:                         cognitoidentity.New(sess.Copy(c.serviceConfig("cognitoidentity"))),
+		cognitoidpconn:                      cognitoidentityprovider.New(sess.Copy(c.serviceConfig("cognitoidp"))),
+		configconn:                          configservice.New(sess.Copy(c.serviceConfig("configservice"))),
+		costandusagereportconn:              costandusagereportservice.New(sess.Copy(c.serviceConfig("cur"))),
+		dataexchangeconn:                    dataexchange.New(sess.Copy(c.serviceConfig("dataexchange"))),
+		datapipelineconn:                    datapipeline.New(sess.Copy(c.serviceConfig("datapipeline"))),
+		datasyncconn:                        datasync.New(sess.Copy(c.serviceConfig("datasync"))),
+		daxconn:                             dax.New(sess.Copy(c.serviceConfig("dax"))),
+		DefaultTagsConfig:                   c.DefaultTagsConfig,
+		devicefarmconn:                      devicefarm.New(sess.Copy(c.serviceConfig("devicefarm"))),
+		dlmconn:                             dlm.New(sess.Copy(c.serviceConfig("dlm"))),
+		dmsconn:                             databasemigrationservice.New(sess.Copy(c.serviceConfig("dms"))),
 		dnsSuffix:                           dnsSuffix,
-		docdbconn:                           docdb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["docdb"])})),
-		dsconn:                              directoryservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ds"])})),
-		dxconn:                              directconnect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["directconnect"])})),
-		dynamodbconn:                        dynamodb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["dynamodb"])})),
-		ec2conn:                             ec2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ec2"])})),
-		ecrconn:                             ecr.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ecr"])})),
-		ecsconn:                             ecs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ecs"])})),
-		efsconn:                             efs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["efs"])})),
-		eksconn:                             eks.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["eks"])})),
-		elasticacheconn:                     elasticache.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["elasticache"])})),
-		elasticbeanstalkconn:                elasticbeanstalk.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["elasticbeanstalk"])})),
-		elastictranscoderconn:               elastictranscoder.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["elastictranscoder"])})),
-		elbconn:                             elb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["elb"])})),
-		elbv2conn:                           elbv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["elb"])})),
-		emrconn:                             emr.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["emr"])})),
-		esconn:                              elasticsearch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["es"])})),
-		firehoseconn:                        firehose.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["firehose"])})),
-		fmsconn:                             fms.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["fms"])})),
-		forecastconn:                        forecastservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["forecast"])})),
-		fsxconn:                             fsx.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["fsx"])})),
-		gameliftconn:                        gamelift.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["gamelift"])})),
-		glacierconn:                         glacier.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["glacier"])})),
-		glueconn:                            glue.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["glue"])})),
-		guarddutyconn:                       guardduty.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["guardduty"])})),
-		greengrassconn:                      greengrass.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["greengrass"])})),
-		iamconn:                             iam.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["iam"])})),
-		identitystoreconn:                   identitystore.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["identitystore"])})),
+		docdbconn:                           docdb.New(sess.Copy(c.serviceConfig("docdb"))),
+		dsconn:                              directoryservice.New(sess.Copy(c.serviceConfig("ds"))),
+		dxconn:                              directconnect.New(sess.Copy(c.serviceConfig("directconnect"))),
+		dynamodbconn:                        dynamodb.New(sess.Copy(c.serviceConfig("dynamodb"))),
+		ec2conn:                             ec2.New(sess.Copy(c.serviceConfig("ec2"))),
+		ecrconn:                             ecr.New(sess.Copy(c.serviceConfig("ecr"))),
+		ecsconn:                             ecs.New(sess.Copy(c.serviceConfig("ecs"))),
+		efsconn:                             efs.New(sess.Copy(c.serviceConfig("efs"))),
+		eksconn:                             eks.New(sess.Copy(c.serviceConfig("eks"))),
+		elasticacheconn:                     elasticache.New(sess.Copy(c.serviceConfig("elasticache"))),
+		elasticbeanstalkconn:                elasticbeanstalk.New(sess.Copy(c.serviceConfig("elasticbeanstalk"))),
+		elastictranscoderconn:               elastictranscoder.New(sess.Copy(c.serviceConfig("elastictranscoder"))),
+		elbconn:                             elb.New(sess.Copy(c.serviceConfig("elb"))),
+		elbv2conn:                           elbv2.New(sess.Copy(c.serviceConfig("elb"))),
+		emrconn:                             emr.New(sess.Copy(c.serviceConfig("emr"))),
+		esconn:                              elasticsearch.New(sess.Copy(c.serviceConfig("es"))),
+		firehoseconn:                        firehose.New(sess.Copy(c.serviceConfig("firehose"))),
+		fmsconn:                             fms.New(sess.Copy(c.serviceConfig("fms"))),
+		forecastconn:                        forecastservice.New(sess.Copy(c.serviceConfig("forecast"))),
+		fsxconn:                             fsx.New(sess.Copy(c.serviceConfig("fsx"))),
+		gameliftconn:                        gamelift.New(sess.Copy(c.serviceConfig("gamelift"))),
+		glacierconn:                         glacier.New(sess.Copy(c.serviceConfig("glacier"))),
+		glueconn:                            glue.New(sess.Copy(c.serviceConfig("glue"))),
+		guarddutyconn:                       guardduty.New(sess.Copy(c.serviceConfig("guardduty"))),
+		greengrassconn:                      greengrass.New(sess.Copy(c.serviceConfig("greengrass"))),
+		iamconn:                             iam.New(sess.Copy(c.serviceConfig("iam"))),
+		identitystoreconn:                   identitystore.New(sess.Copy(c.serviceConfig("identitystore"))),
 		IgnoreTagsConfig:                    c.IgnoreTagsConfig,
-		imagebuilderconn:                    imagebuilder.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["imagebuilder"])})),
-		inspectorconn:                       inspector.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["inspector"])})),
-		iotconn:                             iot.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["iot"])})),
-		iotanalyticsconn:                    iotanalytics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["iotanalytics"])})),
-		ioteventsconn:                       iotevents.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["iotevents"])})),
-		kafkaconn:                           kafka.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["kafka"])})),
-		kinesisanalyticsconn:                kinesisanalytics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["kinesisanalytics"])})),
-		kinesisanalyticsv2conn:              kinesisanalyticsv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["kinesisanalyticsv2"])})),
-		kinesisconn:                         kinesis.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["kinesis"])})),
-		kinesisvideoconn:                    kinesisvideo.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["kinesisvideo"])})),
-		kmsconn:                             kms.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["kms"])})),
-		lakeformationconn:                   lakeformation.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["lakeformation"])})),
-		lambdaconn:                          lambda.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["lambda"])})),
-		lexmodelconn:                        lexmodelbuildingservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["lexmodels"])})),
-		licensemanagerconn:                  licensemanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["licensemanager"])})),
-		lightsailconn:                       lightsail.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["lightsail"])})),
-		macieconn:                           macie.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["macie"])})),
-		macie2conn:                          macie2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["macie2"])})),
-		managedblockchainconn:               managedblockchain.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["managedblockchain"])})),
-		marketplacecatalogconn:              marketplacecatalog.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["marketplacecatalog"])})),
-		mediaconnectconn:                    mediaconnect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["mediaconnect"])})),
-		mediaconvertconn:                    mediaconvert.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["mediaconvert"])})),
-		medialiveconn:                       medialive.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["medialive"])})),
-		mediapackageconn:                    mediapackage.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["mediapackage"])})),
-		mediastoreconn:                      mediastore.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["mediastore"])})),
-		mediastoredataconn:                  mediastoredata.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["mediastoredata"])})),
-		mqconn:                              mq.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["mq"])})),
-		neptuneconn:                         neptune.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["neptune"])})),
-		networkmanagerconn:                  networkmanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["networkmanager"])})),
-		opsworksconn:                        opsworks.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["opsworks"])})),
-		organizationsconn:                   organizations.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["organizations"])})),
-		outpostsconn:                        outposts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["outposts"])})),
+		imagebuilderconn:                    imagebuilder.New(sess.Copy(c.serviceConfig("imagebuilder"))),
+		inspectorconn:                       inspector.New(sess.Copy(c.serviceConfig("inspector"))),
+		iotconn:                             iot.New(sess.Copy(c.serviceConfig("iot"))),
+		iotanalyticsconn:                    iotanalytics.New(sess.Copy(c.serviceConfig("iotanalytics"))),
+		ioteventsconn:                       iotevents.New(sess.Copy(c.serviceConfig("iotevents"))),
+		kafkaconn:                           kafka.New(sess.Copy(c.serviceConfig("kafka"))),
+		kinesisanalyticsconn:                kinesisanalytics.New(sess.Copy(c.serviceConfig("kinesisanalytics"))),
+		kinesisanalyticsv2conn:              kinesisanalyticsv2.New(sess.Copy(c.serviceConfig("kinesisanalyticsv2"))),
+		kinesisconn:                         kinesis.New(sess.Copy(c.serviceConfig("kinesis"))),
+		kinesisvideoconn:                    kinesisvideo.New(sess.Copy(c.serviceConfig("kinesisvideo"))),
+		kmsconn:                             kms.New(sess.Copy(c.serviceConfig("kms"))),
+		lakeformationconn:                   lakeformation.New(sess.Copy(c.serviceConfig("lakeformation"))),
+		lambdaconn:                          lambda.New(sess.Copy(c.serviceConfig("lambda"))),
+		lexmodelconn:                        lexmodelbuildingservice.New(sess.Copy(c.serviceConfig("lexmodels"))),
+		licensemanagerconn:                  licensemanager.New(sess.Copy(c.serviceConfig("licensemanager"))),
+		lightsailconn:                       lightsail.New(sess.Copy(c.serviceConfig("lightsail"))),
+		macieconn:                           macie.New(sess.Copy(c.serviceConfig("macie"))),
+		macie2conn:                          macie2.New(sess.Copy(c.serviceConfig("macie2"))),
+		managedblockchainconn:               managedblockchain.New(sess.Copy(c.serviceConfig("managedblockchain"))),
+		marketplacecatalogconn:              marketplacecatalog.New(sess.Copy(c.serviceConfig("marketplacecatalog"))),
+		mediaconnectconn:                    mediaconnect.New(sess.Copy(c.serviceConfig("mediaconnect"))),
+		mediaconvertconn:                    mediaconvert.New(sess.Copy(c.serviceConfig("mediaconvert"))),
+		medialiveconn:                       medialive.New(sess.Copy(c.serviceConfig("medialive"))),
+		mediapackageconn:                    mediapackage.New(sess.Copy(c.serviceConfig("mediapackage"))),
+		mediastoreconn:                      mediastore.New(sess.Copy(c.serviceConfig("mediastore"))),
+		mediastoredataconn:                  mediastoredata.New(sess.Copy(c.serviceConfig("mediastoredata"))),
+		mqconn:                              mq.New(sess.Copy(c.serviceConfig("mq"))),
+		neptuneconn:                         neptune.New(sess.Copy(c.serviceConfig("neptune"))),
+		networkmanagerconn:                  networkmanager.New(sess.Copy(c.serviceConfig("networkmanager"))),
+		opsworksconn:                        opsworks.New(sess.Copy(c.serviceConfig("opsworks"))),
+		organizationsconn:                   organizations.New(sess.Copy(c.serviceConfig("organizations"))),
+		outpostsconn:                        outposts.New(sess.Copy(c.serviceConfig("outposts"))),
 		partition:                           partition,
-		personalizeconn:                     personalize.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["personalize"])})),
-		pinpointconn:                        pinpoint.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["pinpoint"])})),
-		pricingconn:                         pricing.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["pricing"])})),
-		qldbconn:                            qldb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["qldb"])})),
-		quicksightconn:                      quicksight.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["quicksight"])})),
-		ramconn:                             ram.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ram"])})),
-		rdsconn:                             rds.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["rds"])})),
-		redshiftconn:                        redshift.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["redshift"])})),
+		personalizeconn:                     personalize.New(sess.Copy(c.serviceConfig("personalize"))),
+		pinpointconn:                        pinpoint.New(sess.Copy(c.serviceConfig("pinpoint"))),
+		pricingconn:                         pricing.New(sess.Copy(c.serviceConfig("pricing"))),
+		qldbconn:                            qldb.New(sess.Copy(c.serviceConfig("qldb"))),
+		quicksightconn:                      quicksight.New(sess.Copy(c.serviceConfig("quicksight"))),
+		ramconn:                             ram.New(sess.Copy(c.serviceConfig("ram"))),
+		rdsconn:                             rds.New(sess.Copy(c.serviceConfig("rds"))),
+		redshiftconn:                        redshift.New(sess.Copy(c.serviceConfig("redshift"))),
 		region:                              c.Region,
-		resourcegroupsconn:                  resourcegroups.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["resourcegroups"])})),
-		resourcegroupstaggingapiconn:        resourcegroupstaggingapi.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["resourcegroupstaggingapi"])})),
-		route53domainsconn:                  route53domains.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["route53domains"])})),
-		route53resolverconn:                 route53resolver.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["route53resolver"])})),
-		s3controlconn:                       s3control.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["s3control"])})),
-		sagemakerconn:                       sagemaker.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["sagemaker"])})),
-		scconn:                              servicecatalog.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["servicecatalog"])})),
-		sdconn:                              servicediscovery.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["servicediscovery"])})),
-		secretsmanagerconn:                  secretsmanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["secretsmanager"])})),
-		securityhubconn:                     securityhub.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["securityhub"])})),
-		serverlessapplicationrepositoryconn: serverlessapplicationrepository.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["serverlessrepo"])})),
-		servicequotasconn:                   servicequotas.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["servicequotas"])})),
-		sesconn:                             ses.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ses"])})),
-		sfnconn:                             sfn.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["stepfunctions"])})),
-		simpledbconn:                        simpledb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["sdb"])})),
-		snsconn:                             sns.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["sns"])})),
-		sqsconn:                             sqs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["sqs"])})),
-		ssmconn:                             ssm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ssm"])})),
-		ssoadminconn:                        ssoadmin.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ssoadmin"])})),
-		storagegatewayconn:                  storagegateway.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["storagegateway"])})),
-		stsconn:                             sts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["sts"])})),
-		swfconn:                             swf.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["swf"])})),
-		syntheticsconn:                      synthetics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["synthetics"])})),
+		resourcegroupsconn:                  resourcegroups.New(sess.Copy(c.serviceConfig("resourcegroups"))),
+		resourcegroupstaggingapiconn:        resourcegroupstaggingapi.New(sess.Copy(c.serviceConfig("resourcegroupstaggingapi"))),
+		route53domainsconn:                  route53domains.New(sess.Copy(c.serviceConfig("route53domains"))),
+		route53resolverconn:                 route53resolver.New(sess.Copy(c.serviceConfig("route53resolver"))),
+		s3controlconn:                       s3control.New(sess.Copy(c.serviceConfig("s3control"))),
+		sagemakerconn:                       sagemaker.New(sess.Copy(c.serviceConfig("sagemaker"))),
+		scconn:                              servicecatalog.New(sess.Copy(c.serviceConfig("servicecatalog"))),
+		sdconn:                              servicediscovery.New(sess.Copy(c.serviceConfig("servicediscovery"))),
+		secretsmanagerconn:                  secretsmanager.New(sess.Copy(c.serviceConfig("secretsmanager"))),
+		securityhubconn:                     securityhub.New(sess.Copy(c.serviceConfig("securityhub"))),
+		serverlessapplicationrepositoryconn: serverlessapplicationrepository.New(sess.Copy(c.serviceConfig("serverlessrepo"))),
+		servicequotasconn:                   servicequotas.New(sess.Copy(c.serviceConfig("servicequotas"))),
+		sesconn:                             ses.New(sess.Copy(c.serviceConfig("ses"))),
+		sfnconn:                             sfn.New(sess.Copy(c.serviceConfig("stepfunctions"))),
+		simpledbconn:                        simpledb.New(sess.Copy(c.serviceConfig("sdb"))),
+		snsconn:                             sns.New(sess.Copy(c.serviceConfig("sns"))),
+		sqsconn:                             sqs.New(sess.Copy(c.serviceConfig("sqs"))),
+		ssmconn:                             ssm.New(sess.Copy(c.serviceConfig("ssm"))),
+		ssoadminconn:                        ssoadmin.New(sess.Copy(c.serviceConfig("ssoadmin"))),
+		storagegatewayconn:                  storagegateway.New(sess.Copy(c.serviceConfig("storagegateway"))),
+		stsconn:                             sts.New(sess.Copy(c.serviceConfig("sts"))),
+		swfconn:                             swf.New(sess.Copy(c.serviceConfig("swf"))),
+		syntheticsconn:                      synthetics.New(sess.Copy(c.serviceConfig("synthetics"))),
 		terraformVersion:                    c.terraformVersion,
-		timestreamwriteconn:                 timestreamwrite.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["timestreamwrite"])})),
-		transferconn:                        transfer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["transfer"])})),
-		wafconn:                             waf.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["waf"])})),
-		wafregionalconn:                     wafregional.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["wafregional"])})),
-		wafv2conn:                           wafv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["wafv2"])})),
-		worklinkconn:                        worklink.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["worklink"])})),
-		workmailconn:                        workmail.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["workmail"])})),
-		workspacesconn:                      workspaces.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["workspaces"])})),
-		xrayconn:                            xray.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["xray"])})),
+		timestreamwriteconn:                 timestreamwrite.New(sess.Copy(c.serviceConfig("timestreamwrite"))),
+		transferconn:                        transfer.New(sess.Copy(c.serviceConfig("transfer"))),
+		wafconn:                             waf.New(sess.Copy(c.serviceConfig("waf"))),
+		wafregionalconn:                     wafregional.New(sess.Copy(c.serviceConfig("wafregional"))),
+		wafv2conn:                           wafv2.New(sess.Copy(c.serviceConfig("wafv2"))),
+		worklinkconn:                        worklink.New(sess.Copy(c.serviceConfig("worklink"))),
+		workmailconn:                        workmail.New(sess.Copy(c.serviceConfig("workmail"))),
+		workspacesconn:                      workspaces.New(sess.Copy(c.serviceConfig("workspaces"))),
+		xrayconn:                            xray.New(sess.Copy(c.serviceConfig("xray"))),
 	}
 
 	// "Global" services that require customizations
-	globalAcceleratorConfig := &aws.Config{
-		Endpoint: aws.String(c.Endpoints["globalaccelerator"]),
-	}
-	route53Config := &aws.Config{
-		Endpoint: aws.String(c.Endpoints["route53"]),
-	}
-	shieldConfig := &aws.Config{
-		Endpoint: aws.String(c.Endpoints["shield"]),
-	}
+	globalAcceleratorConfig := c.serviceConfig("globalaccelerator")
+	route53Config := c.serviceConfig("route53")
+	shieldConfig := c.serviceConfig("shield")
 
 	// Services that require multiple client configurations
-	s3Config := &aws.Config{
-		Endpoint:         aws.String(c.Endpoints["s3"]),
-		S3ForcePathStyle: aws.Bool(c.S3ForcePathStyle),
-	}
+	s3Config := c.serviceConfig("s3")
+	s3Config.S3ForcePathStyle = aws.Bool(c.S3ForcePathStyle)
 
 	client.s3conn = s3.New(sess.Copy(s3Config))
 
@@ -772,6 +879,428 @@ func (c *Config) Client() (interface{}, error) {
 	return client, nil
 }
 
+// chainedCredentials returns refreshable credentials for the IAM Role configured
+// in the assume_role_with_web_identity configuration block, if any, followed by
+// each IAM Role configured in the assume_role configuration blocks in order.
+func (c *Config) chainedCredentials(awsbaseConfig *awsbase.Config) (*credentials.Credentials, error) {
+	var creds *credentials.Credentials
+
+	if c.AssumeRoleWithWebIdentityRoleARN != "" {
+		var err error
+		creds, err = c.webIdentityCredentials(awsbaseConfig)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := creds.Get(); err != nil {
+			return nil, fmt.Errorf("error assuming IAM Role (%s) with web identity: %w", c.AssumeRoleWithWebIdentityRoleARN, err)
+		}
+	} else {
+		var err error
+		creds, err = c.baseCredentials(awsbaseConfig)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	roles := make([]*AssumeRole, 0, len(c.AssumeRoleChain)+1)
+	roles = append(roles, c.AssumeRoleChain...)
+
+	if c.AssumeRoleARN != "" {
+		roles = append(roles, &AssumeRole{
+			DurationSeconds:   c.AssumeRoleDurationSeconds,
+			ExternalID:        c.AssumeRoleExternalID,
+			Policy:            c.AssumeRolePolicy,
+			PolicyARNs:        c.AssumeRolePolicyARNs,
+			RoleARN:           c.AssumeRoleARN,
+			SessionName:       c.AssumeRoleSessionName,
+			Tags:              c.AssumeRoleTags,
+			TransitiveTagKeys: c.AssumeRoleTransitiveTagKeys,
+		})
+	}
+
+	for _, role := range roles {
+		sess, err := c.stsSession(awsbaseConfig, creds)
+
+		if err != nil {
+			return nil, err
+		}
+
+		log.Printf("[INFO] Attempting to AssumeRole %s (SessionName: %q, ExternalId: %q)", role.RoleARN, role.SessionName, role.ExternalID)
+
+		creds = credentials.NewCredentials(role.provider(sts.New(sess)))
+
+		if _, err := creds.Get(); err != nil {
+			return nil, fmt.Errorf("error assuming IAM Role (%s): %w", role.RoleARN, err)
+		}
+	}
+
+	return creds, nil
+}
+
+// baseCredentials returns the credentials from the configured static credentials,
+// environment variables or shared credentials file, or from the EC2 Instance
+// Metadata Service if a custom endpoint is configured, before any role is assumed.
+func (c *Config) baseCredentials(awsbaseConfig *awsbase.Config) (*credentials.Credentials, error) {
+	if c.EC2MetadataServiceEndpoint == "" || c.SkipMetadataApiCheck {
+		baseConfig := *awsbaseConfig
+		baseConfig.AssumeRoleARN = ""
+
+		return awsbase.GetCredentials(&baseConfig)
+	}
+
+	sharedCredentialsFilename, err := homedir.Expand(c.CredsFilename)
+
+	if err != nil {
+		return nil, fmt.Errorf("error expanding shared credentials filename: %w", err)
+	}
+
+	// The EC2 Instance Metadata Service is not reached through the HTTP proxy.
+	sess, err := session.NewSession(&aws.Config{
+		Endpoint:   aws.String(c.EC2MetadataServiceEndpoint),
+		HTTPClient: cleanhttp.DefaultClient(),
+		Region:     aws.String(c.Region),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("error creating EC2 Instance Metadata Service session: %w", err)
+	}
+
+	providers := []credentials.Provider{
+		&credentials.StaticProvider{Value: credentials.Value{
+			AccessKeyID:     c.AccessKey,
+			SecretAccessKey: c.SecretKey,
+			SessionToken:    c.Token,
+		}},
+		&credentials.EnvProvider{},
+		&credentials.SharedCredentialsProvider{
+			Filename: sharedCredentialsFilename,
+			Profile:  c.Profile,
+		},
+		&ec2rolecreds.EC2RoleProvider{
+			Client: ec2metadata.New(sess),
+		},
+	}
+
+	creds := credentials.NewChainCredentials(providers)
+
+	if _, err := creds.Get(); err != nil {
+		return nil, fmt.Errorf("error loading credentials for AWS Provider: %w", err)
+	}
+
+	return creds, nil
+}
+
+// accountIDAndPartition validates the credentials of the session and returns
+// the account ID and partition, honoring the skip_credentials_validation and
+// skip_requesting_account_id provider arguments.
+func (c *Config) accountIDAndPartition(sess *session.Session) (string, string, error) {
+	stsconn := sts.New(sess.Copy(c.serviceConfig("sts")))
+
+	if !c.SkipCredsValidation {
+		accountID, partition, err := awsbase.GetAccountIDAndPartitionFromSTSGetCallerIdentity(stsconn)
+
+		if err != nil {
+			return "", "", fmt.Errorf("error validating provider credentials: %w", err)
+		}
+
+		return accountID, partition, nil
+	}
+
+	if !c.SkipRequestingAccountId {
+		credentialsProviderName := ""
+
+		if credentialsValue, err := sess.Config.Credentials.Get(); err == nil {
+			credentialsProviderName = credentialsValue.ProviderName
+		}
+
+		accountID, partition, err := awsbase.GetAccountIDAndPartition(iam.New(sess.Copy(c.serviceConfig("iam"))), stsconn, credentialsProviderName)
+
+		if err != nil {
+			return "", "", fmt.Errorf(
+				"AWS account ID not previously found and failed retrieving via all available methods. "+
+					"See https://www.terraform.io/docs/providers/aws/index.html#skip_requesting_account_id for workaround and implications. "+
+					"Errors: %w", err)
+		}
+
+		return accountID, partition, nil
+	}
+
+	var partition string
+	if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), c.Region); ok {
+		partition = p.ID()
+	}
+
+	return "", partition, nil
+}
+
+// httpClient returns the HTTP client for AWS API requests, configured with
+// the HTTP proxy, custom CA bundle and TLS settings.
+func (c *Config) httpClient() (*http.Client, error) {
+	httpClient := cleanhttp.DefaultClient()
+	transport := httpClient.Transport.(*http.Transport)
+
+	if c.HTTPProxy != "" {
+		proxyURL, err := url.Parse(c.HTTPProxy)
+
+		if err != nil {
+			return nil, fmt.Errorf("error parsing HTTP proxy URL (%s): %w", c.HTTPProxy, err)
+		}
+
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	tlsConfig := &tls.Config{}
+
+	if c.CustomCABundle != "" {
+		filename, err := homedir.Expand(c.CustomCABundle)
+
+		if err != nil {
+			return nil, fmt.Errorf("error expanding custom CA bundle filename: %w", err)
+		}
+
+		pem, err := ioutil.ReadFile(filename)
+
+		if err != nil {
+			return nil, fmt.Errorf("error reading custom CA bundle (%s): %w", filename, err)
+		}
+
+		tlsConfig.RootCAs = x509.NewCertPool()
+
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("error loading custom CA bundle (%s): no PEM encoded certificates found", filename)
+		}
+	}
+
+	if c.Insecure {
+		tlsConfig.InsecureSkipVerify = true
+	}
+
+	transport.TLSClientConfig = tlsConfig
+
+	return httpClient, nil
+}
+
+// webIdentityCredentials returns refreshable credentials for the IAM Role
+// configured in the assume_role_with_web_identity configuration block.
+func (c *Config) webIdentityCredentials(awsbaseConfig *awsbase.Config) (*credentials.Credentials, error) {
+	sess, err := c.stsSession(awsbaseConfig, credentials.AnonymousCredentials)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var tokenFetcher stscreds.TokenFetcher = stscreds.FetchTokenPath(c.AssumeRoleWithWebIdentityTokenFile)
+
+	if c.AssumeRoleWithWebIdentityToken != "" {
+		tokenFetcher = webIdentityToken(c.AssumeRoleWithWebIdentityToken)
+	}
+
+	log.Printf("[INFO] Attempting to AssumeRoleWithWebIdentity %s (SessionName: %q)", c.AssumeRoleWithWebIdentityRoleARN, c.AssumeRoleWithWebIdentitySessionName)
+
+	provider := stscreds.NewWebIdentityRoleProviderWithToken(sts.New(sess), c.AssumeRoleWithWebIdentityRoleARN, c.AssumeRoleWithWebIdentitySessionName, tokenFetcher)
+
+	if c.AssumeRoleWithWebIdentityDuration > 0 {
+		provider.Duration = c.AssumeRoleWithWebIdentityDuration
+	}
+
+	return credentials.NewCredentials(provider), nil
+}
+
+// stsSession returns a session for STS requests with the given credentials.
+// Only the STS endpoint and region settings of the awsbase configuration are honored.
+func (c *Config) stsSession(awsbaseConfig *awsbase.Config, creds *credentials.Credentials) (*session.Session, error) {
+	httpClient, err := c.httpClient()
+
+	if err != nil {
+		return nil, err
+	}
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials:      creds,
+		Endpoint:         aws.String(awsbaseConfig.StsEndpoint),
+		EndpointResolver: c.endpointResolver(awsbaseConfig.EndpointResolver()),
+		HTTPClient:       httpClient,
+		MaxRetries:       aws.Int(c.MaxRetries),
+		Region:           aws.String(c.Region),
+		UseDualStack:     aws.Bool(c.UseDualStackEndpoint),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("error creating STS session: %w", err)
+	}
+
+	return sess, nil
+}
+
+// endpointResolver returns the endpoint resolver for service clients, which
+// resolves the FIPS endpoints of services if configured.
+func (c *Config) endpointResolver(resolver endpoints.Resolver) endpoints.Resolver {
+	if !c.UseFIPSEndpoint {
+		return resolver
+	}
+
+	return &fipsEndpointResolver{
+		partitions: endpoints.DefaultPartitions(),
+	}
+}
+
+// fipsGlobalEndpoints are the FIPS endpoints of global services, which the
+// AWS Go SDK always resolves to the partition endpoint of the service.
+var fipsGlobalEndpoints = map[string]map[string]endpoints.ResolvedEndpoint{
+	endpoints.AwsPartitionID: {
+		"iam":           {URL: "https://iam-fips.amazonaws.com", SigningRegion: endpoints.UsEast1RegionID},
+		"organizations": {URL: "https://organizations-fips.us-east-1.amazonaws.com", SigningRegion: endpoints.UsEast1RegionID},
+		"shield":        {URL: "https://shield-fips.us-east-1.amazonaws.com", SigningRegion: endpoints.UsEast1RegionID},
+		"waf":           {URL: "https://waf-fips.amazonaws.com", SigningRegion: endpoints.UsEast1RegionID},
+	},
+}
+
+// fipsEndpointResolver resolves the FIPS endpoints of services, which the
+// AWS Go SDK models as separate endpoints, e.g. fips-us-east-1 or us-east-1-fips.
+// Clients of services without a FIPS endpoint fail any request with an error.
+type fipsEndpointResolver struct {
+	partitions []endpoints.Partition
+}
+
+func (r *fipsEndpointResolver) EndpointFor(service, region string, opts ...func(*endpoints.Options)) (endpoints.ResolvedEndpoint, error) {
+	partition, ok := endpoints.PartitionForRegion(r.partitions, region)
+
+	if !ok {
+		return endpoints.ResolvedEndpoint{}, fmt.Errorf("error resolving FIPS endpoint for service (%s): unknown region (%s), configure the service endpoint in the provider endpoints configuration block instead", service, region)
+	}
+
+	if endpoint, ok := fipsGlobalEndpoints[partition.ID()][service]; ok {
+		endpoint.SigningName = service
+		endpoint.SigningMethod = "v4"
+
+		return endpoint, nil
+	}
+
+	if s, ok := partition.Services()[service]; ok {
+		serviceEndpoints := s.Endpoints()
+
+		for _, id := range []string{
+			fmt.Sprintf("fips-%s", region),
+			fmt.Sprintf("%s-fips", region),
+			fmt.Sprintf("%s-fips", service),
+		} {
+			if endpoint, ok := serviceEndpoints[id]; ok {
+				return endpoint.ResolveEndpoint(opts...)
+			}
+		}
+	}
+
+	return endpoints.ResolvedEndpoint{}, fmt.Errorf("service (%s) has no FIPS endpoint in region (%s), configure the service endpoint in the provider endpoints configuration block instead", service, region)
+}
+
+// provider returns an AssumeRoleProvider for the IAM Role using the given STS client.
+func (role *AssumeRole) provider(conn *sts.STS) *stscreds.AssumeRoleProvider {
+	provider := &stscreds.AssumeRoleProvider{
+		Client:  conn,
+		RoleARN: role.RoleARN,
+	}
+
+	if role.DurationSeconds > 0 {
+		provider.Duration = time.Duration(role.DurationSeconds) * time.Second
+	}
+
+	if role.ExternalID != "" {
+		provider.ExternalID = aws.String(role.ExternalID)
+	}
+
+	if role.Policy != "" {
+		provider.Policy = aws.String(role.Policy)
+	}
+
+	for _, policyARN := range role.PolicyARNs {
+		provider.PolicyArns = append(provider.PolicyArns, &sts.PolicyDescriptorType{
+			Arn: aws.String(policyARN),
+		})
+	}
+
+	if role.SessionName != "" {
+		provider.RoleSessionName = role.SessionName
+	}
+
+	for k, v := range role.Tags {
+		provider.Tags = append(provider.Tags, &sts.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		})
+	}
+
+	if len(role.TransitiveTagKeys) > 0 {
+		provider.TransitiveTagKeys = aws.StringSlice(role.TransitiveTagKeys)
+	}
+
+	return provider
+}
+
+// webIdentityToken implements stscreds.TokenFetcher for a token given directly in the configuration.
+type webIdentityToken string
+
+func (t webIdentityToken) FetchToken(credentials.Context) ([]byte, error) {
+	return []byte(t), nil
+}
+
+// serviceConfig returns the AWS Go SDK configuration for the given service
+// endpoint name, including any custom endpoint and retry settings.
+func (c *Config) serviceConfig(endpointServiceName string) *aws.Config {
+	config := &aws.Config{
+		Endpoint: aws.String(c.Endpoints[endpointServiceName]),
+	}
+
+	if retryConfig, ok := c.Retries[endpointServiceName]; ok {
+		request.WithRetryer(config, retryConfig.retryer(c.MaxRetries))
+	}
+
+	return config
+}
+
+// retryer returns a request.Retryer implementing the retry settings.
+// maxRetries is used when the maximum number of attempts is not configured.
+func (rc *RetryConfig) retryer(maxRetries int) request.Retryer {
+	if rc.MaxAttempts > 0 {
+		maxRetries = rc.MaxAttempts - 1
+	}
+
+	retryer := &serviceRetryer{
+		DefaultRetryer: client.DefaultRetryer{
+			NumMaxRetries:    maxRetries,
+			MinRetryDelay:    rc.BaseDelay,
+			MinThrottleDelay: rc.BaseDelay,
+			MaxRetryDelay:    rc.MaxDelay,
+			MaxThrottleDelay: rc.MaxDelay,
+		},
+		retryableErrorCodes: make(map[string]bool),
+	}
+
+	for _, code := range rc.RetryableErrorCodes {
+		retryer.retryableErrorCodes[code] = true
+	}
+
+	return retryer
+}
+
+// serviceRetryer is the AWS Go SDK default retryer, additionally retrying
+// requests failing with any of the configured error codes.
+type serviceRetryer struct {
+	client.DefaultRetryer
+
+	retryableErrorCodes map[string]bool
+}
+
+func (r *serviceRetryer) ShouldRetry(req *request.Request) bool {
+	if err, ok := req.Error.(awserr.Error); ok && r.retryableErrorCodes[err.Code()] {
+		return true
+	}
+
+	return r.DefaultRetryer.ShouldRetry(req)
+}
+
 func hasEc2Classic(platforms []string) bool {
 	for _, p := range platforms {
 		if p == "EC2" {
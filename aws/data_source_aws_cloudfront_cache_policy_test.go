@@ -0,0 +1,112 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAWSDataSourceCloudFrontCachePolicy_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSource1Name := "data.aws_cloudfront_cache_policy.by_id"
+	dataSource2Name := "data.aws_cloudfront_cache_policy.by_name"
+	resourceName := "aws_cloudfront_cache_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCloudFront(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontCachePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFrontCachePolicyDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSource1Name, "comment", resourceName, "comment"),
+					resource.TestCheckResourceAttrPair(dataSource1Name, "default_ttl", resourceName, "default_ttl"),
+					resource.TestCheckResourceAttrPair(dataSource1Name, "etag", resourceName, "etag"),
+					resource.TestCheckResourceAttrPair(dataSource1Name, "max_ttl", resourceName, "max_ttl"),
+					resource.TestCheckResourceAttrPair(dataSource1Name, "min_ttl", resourceName, "min_ttl"),
+					resource.TestCheckResourceAttrPair(dataSource1Name, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSource1Name, "parameters_in_cache_key_and_forwarded_to_origin.#", resourceName, "parameters_in_cache_key_and_forwarded_to_origin.#"),
+					resource.TestCheckResourceAttrPair(dataSource1Name, "parameters_in_cache_key_and_forwarded_to_origin.0.cookies_config.0.cookie_behavior", resourceName, "parameters_in_cache_key_and_forwarded_to_origin.0.cookies_config.0.cookie_behavior"),
+					resource.TestCheckResourceAttrPair(dataSource1Name, "parameters_in_cache_key_and_forwarded_to_origin.0.cookies_config.0.cookies.0.items.#", resourceName, "parameters_in_cache_key_and_forwarded_to_origin.0.cookies_config.0.cookies.0.items.#"),
+					resource.TestCheckResourceAttrPair(dataSource2Name, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSource2Name, "etag", resourceName, "etag"),
+					resource.TestCheckResourceAttrPair(dataSource2Name, "parameters_in_cache_key_and_forwarded_to_origin.0.headers_config.0.header_behavior", resourceName, "parameters_in_cache_key_and_forwarded_to_origin.0.headers_config.0.header_behavior"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSDataSourceCloudFrontCachePolicy_Managed(t *testing.T) {
+	dataSourceName := "data.aws_cloudfront_cache_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccPreCheckAWSCloudFront(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFrontCachePolicyDataSourceConfigManaged,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", "658327ea-f89d-4fab-a63d-7e88639e58f6"),
+					resource.TestCheckResourceAttr(dataSourceName, "name", "Managed-CachingOptimized"),
+					resource.TestCheckResourceAttr(dataSourceName, "parameters_in_cache_key_and_forwarded_to_origin.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSCloudFrontCachePolicyDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudfront_cache_policy" "test" {
+  name        = %[1]q
+  comment     = "test comment"
+  default_ttl = 50
+  max_ttl     = 100
+  min_ttl     = 1
+
+  parameters_in_cache_key_and_forwarded_to_origin {
+    cookies_config {
+      cookie_behavior = "whitelist"
+
+      cookies {
+        items = ["test"]
+      }
+    }
+
+    headers_config {
+      header_behavior = "whitelist"
+
+      headers {
+        items = ["test"]
+      }
+    }
+
+    query_strings_config {
+      query_string_behavior = "whitelist"
+
+      query_strings {
+        items = ["test"]
+      }
+    }
+  }
+}
+
+data "aws_cloudfront_cache_policy" "by_id" {
+  id = aws_cloudfront_cache_policy.test.id
+}
+
+data "aws_cloudfront_cache_policy" "by_name" {
+  name = aws_cloudfront_cache_policy.test.name
+}
+`, rName)
+}
+
+const testAccAWSCloudFrontCachePolicyDataSourceConfigManaged = `
+data "aws_cloudfront_cache_policy" "test" {
+  name = "Managed-CachingOptimized"
+}
+`
@@ -0,0 +1,105 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAWSDataSourceCloudFrontOriginRequestPolicy_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSource1Name := "data.aws_cloudfront_origin_request_policy.by_id"
+	dataSource2Name := "data.aws_cloudfront_origin_request_policy.by_name"
+	resourceName := "aws_cloudfront_origin_request_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCloudFront(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontOriginRequestPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFrontOriginRequestPolicyDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSource1Name, "comment", resourceName, "comment"),
+					resource.TestCheckResourceAttrPair(dataSource1Name, "cookies_config.#", resourceName, "cookies_config.#"),
+					resource.TestCheckResourceAttrPair(dataSource1Name, "cookies_config.0.cookie_behavior", resourceName, "cookies_config.0.cookie_behavior"),
+					resource.TestCheckResourceAttrPair(dataSource1Name, "cookies_config.0.cookies.0.items.#", resourceName, "cookies_config.0.cookies.0.items.#"),
+					resource.TestCheckResourceAttrPair(dataSource1Name, "etag", resourceName, "etag"),
+					resource.TestCheckResourceAttrPair(dataSource1Name, "headers_config.0.header_behavior", resourceName, "headers_config.0.header_behavior"),
+					resource.TestCheckResourceAttrPair(dataSource1Name, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSource1Name, "query_strings_config.0.query_string_behavior", resourceName, "query_strings_config.0.query_string_behavior"),
+					resource.TestCheckResourceAttrPair(dataSource2Name, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSource2Name, "etag", resourceName, "etag"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSDataSourceCloudFrontOriginRequestPolicy_Managed(t *testing.T) {
+	dataSourceName := "data.aws_cloudfront_origin_request_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccPreCheckAWSCloudFront(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFrontOriginRequestPolicyDataSourceConfigManaged,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", "216adef6-5c7f-47e4-b989-5492eafa07d3"),
+					resource.TestCheckResourceAttr(dataSourceName, "name", "Managed-AllViewer"),
+					resource.TestCheckResourceAttr(dataSourceName, "headers_config.0.header_behavior", "allViewer"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSCloudFrontOriginRequestPolicyDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudfront_origin_request_policy" "test" {
+  name    = %[1]q
+  comment = "test comment"
+
+  cookies_config {
+    cookie_behavior = "whitelist"
+
+    cookies {
+      items = ["test"]
+    }
+  }
+
+  headers_config {
+    header_behavior = "whitelist"
+
+    headers {
+      items = ["test"]
+    }
+  }
+
+  query_strings_config {
+    query_string_behavior = "whitelist"
+
+    query_strings {
+      items = ["test"]
+    }
+  }
+}
+
+data "aws_cloudfront_origin_request_policy" "by_id" {
+  id = aws_cloudfront_origin_request_policy.test.id
+}
+
+data "aws_cloudfront_origin_request_policy" "by_name" {
+  name = aws_cloudfront_origin_request_policy.test.name
+}
+`, rName)
+}
+
+const testAccAWSCloudFrontOriginRequestPolicyDataSourceConfigManaged = `
+data "aws_cloudfront_origin_request_policy" "test" {
+  name = "Managed-AllViewer"
+}
+`
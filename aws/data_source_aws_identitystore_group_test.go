@@ -0,0 +1,84 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAWSIdentityStoreGroupDataSource_DisplayName(t *testing.T) {
+	dataSourceName := "data.aws_identitystore_group.test"
+	name := os.Getenv("AWS_IDENTITY_STORE_GROUP_NAME")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAWSSSOAdminInstances(t)
+			testAccPreCheckAWSIdentityStoreGroupName(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIdentityStoreGroupDataSourceConfigDisplayName(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "group_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "display_name", name),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSIdentityStoreGroupDataSource_NonExistent(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAWSSSOAdminInstances(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSIdentityStoreGroupDataSourceConfigNonExistent,
+				ExpectError: regexp.MustCompile(`no Identity Store Group found matching criteria`),
+			},
+		},
+	})
+}
+
+func testAccPreCheckAWSIdentityStoreGroupName(t *testing.T) {
+	if os.Getenv("AWS_IDENTITY_STORE_GROUP_NAME") == "" {
+		t.Skip("AWS_IDENTITY_STORE_GROUP_NAME env var must be set for AWS Identity Store Group acceptance test. " +
+			"This is required until ability to create groups in AWS SSO is available")
+	}
+}
+
+func testAccAWSIdentityStoreGroupDataSourceConfigDisplayName(name string) string {
+	return fmt.Sprintf(`
+data "aws_ssoadmin_instances" "test" {}
+
+data "aws_identitystore_group" "test" {
+  filter {
+    attribute_path  = "DisplayName"
+    attribute_value = %q
+  }
+
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+}
+`, name)
+}
+
+const testAccAWSIdentityStoreGroupDataSourceConfigNonExistent = `
+data "aws_ssoadmin_instances" "test" {}
+
+data "aws_identitystore_group" "test" {
+  filter {
+    attribute_path  = "DisplayName"
+    attribute_value = "does-not-exist"
+  }
+
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+}
+`
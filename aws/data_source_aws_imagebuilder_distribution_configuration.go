@@ -0,0 +1,138 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/imagebuilder"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func dataSourceAwsImageBuilderDistributionConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsImageBuilderDistributionConfigurationRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+			"date_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_updated": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"distribution": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ami_distribution_configuration": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ami_tags": tagsSchemaComputed(),
+									"description": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"kms_key_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"launch_permission": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"user_groups": {
+													Type:     schema.TypeSet,
+													Computed: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"user_ids": {
+													Type:     schema.TypeSet,
+													Computed: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"target_account_ids": {
+										Type:     schema.TypeSet,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"license_configuration_arns": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceAwsImageBuilderDistributionConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).imagebuilderconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	arn := d.Get("arn").(string)
+	output, err := conn.GetDistributionConfiguration(&imagebuilder.GetDistributionConfigurationInput{
+		DistributionConfigurationArn: aws.String(arn),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading Image Builder Distribution Configuration (%s): %w", arn, err)
+	}
+
+	if output == nil || output.DistributionConfiguration == nil {
+		return fmt.Errorf("error reading Image Builder Distribution Configuration (%s): empty response", arn)
+	}
+
+	distributionConfiguration := output.DistributionConfiguration
+
+	d.SetId(aws.StringValue(distributionConfiguration.Arn))
+	d.Set("arn", distributionConfiguration.Arn)
+	d.Set("date_created", distributionConfiguration.DateCreated)
+	d.Set("date_updated", distributionConfiguration.DateUpdated)
+	d.Set("description", distributionConfiguration.Description)
+	if err := d.Set("distribution", flattenImageBuilderDistributions(distributionConfiguration.Distributions)); err != nil {
+		return fmt.Errorf("error setting distribution: %w", err)
+	}
+	d.Set("name", distributionConfiguration.Name)
+
+	if err := d.Set("tags", keyvaluetags.ImagebuilderKeyValueTags(distributionConfiguration.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAwsLakeFormationDataLakeSettings() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsLakeFormationDataLakeSettingsRead,
+
+		Schema: map[string]*schema.Schema{
+			"admins": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"catalog_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"create_database_default_permissions": dataSourceLakeFormationPrincipalPermissionsSchema(),
+			"create_table_default_permissions":    dataSourceLakeFormationPrincipalPermissionsSchema(),
+			"trusted_resource_owners": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceLakeFormationPrincipalPermissionsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"permissions": {
+					Type:     schema.TypeSet,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"principal": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsLakeFormationDataLakeSettingsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lakeformationconn
+
+	catalogID := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("catalog_id"); ok {
+		catalogID = v.(string)
+	}
+
+	output, err := conn.GetDataLakeSettings(&lakeformation.GetDataLakeSettingsInput{
+		CatalogId: aws.String(catalogID),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading Lake Formation Data Lake Settings (%s): %w", catalogID, err)
+	}
+
+	if output == nil || output.DataLakeSettings == nil {
+		return fmt.Errorf("error reading Lake Formation Data Lake Settings (%s): empty response", catalogID)
+	}
+
+	d.SetId(catalogID)
+	d.Set("catalog_id", catalogID)
+
+	return setLakeFormationDataLakeSettings(d, output.DataLakeSettings)
+}
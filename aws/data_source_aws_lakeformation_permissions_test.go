@@ -0,0 +1,45 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccAWSLakeFormationPermissionsDataSource_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lakeformation_permissions.test"
+	dataSourceName := "data.aws_lakeformation_permissions.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(lakeformation.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLakeFormationPermissionsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLakeFormationPermissionsDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "principal", resourceName, "principal"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "permissions.#", resourceName, "permissions.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "permissions_with_grant_option.#", resourceName, "permissions_with_grant_option.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSLakeFormationPermissionsDataSourceConfig_basic(rName string) string {
+	return composeConfig(
+		testAccAWSLakeFormationPermissionsConfig_database(rName),
+		`
+data "aws_lakeformation_permissions" "test" {
+  principal = aws_lakeformation_permissions.test.principal
+
+  database {
+    name = aws_lakeformation_permissions.test.database[0].name
+  }
+}
+`)
+}
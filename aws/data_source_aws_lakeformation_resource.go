@@ -0,0 +1,57 @@
+package aws
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAwsLakeFormationResource() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsLakeFormationResourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+			"last_modified": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"role_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsLakeFormationResourceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lakeformationconn
+
+	resourceArn := d.Get("arn").(string)
+	output, err := conn.DescribeResource(&lakeformation.DescribeResourceInput{
+		ResourceArn: aws.String(resourceArn),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading Lake Formation Resource (%s): %w", resourceArn, err)
+	}
+
+	if output == nil || output.ResourceInfo == nil {
+		return fmt.Errorf("error reading Lake Formation Resource (%s): empty response", resourceArn)
+	}
+
+	d.SetId(aws.StringValue(output.ResourceInfo.ResourceArn))
+	d.Set("role_arn", output.ResourceInfo.RoleArn)
+	if output.ResourceInfo.LastModified != nil {
+		d.Set("last_modified", aws.TimeValue(output.ResourceInfo.LastModified).Format(time.RFC3339))
+	}
+
+	return nil
+}
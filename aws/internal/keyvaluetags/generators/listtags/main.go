@@ -84,6 +84,7 @@ var serviceNames = []string{
 	"kms",
 	"lambda",
 	"licensemanager",
+	"macie2",
 	"mediaconnect",
 	"mediaconvert",
 	"medialive",
@@ -100,6 +101,7 @@ var serviceNames = []string{
 	"rds",
 	"resourcegroups",
 	"route53",
+	"route53domains",
 	"route53resolver",
 	"sagemaker",
 	"securityhub",
@@ -108,8 +110,10 @@ var serviceNames = []string{
 	"sns",
 	"sqs",
 	"ssm",
+	"ssoadmin",
 	"storagegateway",
 	"swf",
+	"timestreamwrite",
 	"transfer",
 	"waf",
 	"wafregional",
@@ -79,6 +79,7 @@ var sliceServiceNames = []string{
 	"redshift",
 	"resourcegroupstaggingapi",
 	"route53",
+	"route53domains",
 	"route53resolver",
 	"s3",
 	"sagemaker",
@@ -89,8 +90,10 @@ var sliceServiceNames = []string{
 	"sfn",
 	"sns",
 	"ssm",
+	"ssoadmin",
 	"storagegateway",
 	"swf",
+	"timestreamwrite",
 	"transfer",
 	"waf",
 	"wafregional",
@@ -124,6 +127,7 @@ var mapServiceNames = []string{
 	"kinesisvideo",
 	"imagebuilder",
 	"lambda",
+	"macie2",
 	"mediaconnect",
 	"mediaconvert",
 	"medialive",
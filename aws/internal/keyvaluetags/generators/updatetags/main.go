@@ -87,6 +87,7 @@ var serviceNames = []string{
 	"lambda",
 	"licensemanager",
 	"lightsail",
+	"macie2",
 	"mediaconnect",
 	"mediaconvert",
 	"medialive",
@@ -105,6 +106,7 @@ var serviceNames = []string{
 	"redshift",
 	"resourcegroups",
 	"route53",
+	"route53domains",
 	"route53resolver",
 	"sagemaker",
 	"secretsmanager",
@@ -114,9 +116,11 @@ var serviceNames = []string{
 	"sns",
 	"sqs",
 	"ssm",
+	"ssoadmin",
 	"storagegateway",
 	"swf",
 	"synthetics",
+	"timestreamwrite",
 	"transfer",
 	"waf",
 	"wafregional",
@@ -22,8 +22,24 @@ const (
 	ElasticbeanstalkTagKeyPrefix = `elasticbeanstalk:`
 	NameTagKey                   = `Name`
 	RdsTagKeyPrefix              = `rds:`
+	ServerlessRepoTagKeyPrefix   = `serverlessrepo:`
 )
 
+// DefaultConfig contains tags to default across all resources.
+type DefaultConfig struct {
+	Tags KeyValueTags
+}
+
+// MergeTags returns the result of merging the given tags over the default tags.
+// Tags configured on the resource take precedence over default tags.
+func (dc *DefaultConfig) MergeTags(tags KeyValueTags) KeyValueTags {
+	if dc == nil || dc.Tags == nil {
+		return tags
+	}
+
+	return dc.Tags.Merge(tags)
+}
+
 // IgnoreConfig contains various options for removing resource tags.
 type IgnoreConfig struct {
 	Keys        KeyValueTags
@@ -61,6 +77,24 @@ func (tags KeyValueTags) IgnoreConfig(config *IgnoreConfig) KeyValueTags {
 	return result
 }
 
+// RemoveDefaultConfig returns tags not present in a default configuration,
+// in addition to tags whose values differ from the default configuration.
+func (tags KeyValueTags) RemoveDefaultConfig(config *DefaultConfig) KeyValueTags {
+	if config == nil || config.Tags == nil {
+		return tags
+	}
+
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if defaultV, ok := config.Tags[k]; !ok || !v.Equal(defaultV) {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
 // IgnoreElasticbeanstalk returns non-AWS and non-Elasticbeanstalk tag keys.
 func (tags KeyValueTags) IgnoreElasticbeanstalk() KeyValueTags {
 	result := make(KeyValueTags)
@@ -127,6 +161,25 @@ func (tags KeyValueTags) IgnoreRds() KeyValueTags {
 	return result
 }
 
+// IgnoreServerlessApplicationRepository returns non-AWS and non-ServerlessApplicationRepository tag keys.
+func (tags KeyValueTags) IgnoreServerlessApplicationRepository() KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if strings.HasPrefix(k, AwsTagKeyPrefix) {
+			continue
+		}
+
+		if strings.HasPrefix(k, ServerlessRepoTagKeyPrefix) {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
 // Ignore returns non-matching tag keys.
 func (tags KeyValueTags) Ignore(ignoreTags KeyValueTags) KeyValueTags {
 	result := make(KeyValueTags)
@@ -416,10 +469,12 @@ func (tags KeyValueTags) UrlEncode() string {
 }
 
 // New creates KeyValueTags from common Terraform Provider SDK types.
-// Supports map[string]string, map[string]*string, map[string]interface{}, and []interface{}.
+// Supports KeyValueTags, map[string]string, map[string]*string, map[string]interface{}, and []interface{}.
 // When passed []interface{}, all elements are treated as keys and assigned nil values.
 func New(i interface{}) KeyValueTags {
 	switch value := i.(type) {
+	case KeyValueTags:
+		return make(KeyValueTags).Merge(value)
 	case map[string]*TagData:
 		kvtm := make(KeyValueTags, len(value))
 
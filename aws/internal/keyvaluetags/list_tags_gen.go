@@ -71,6 +71,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/kms"
 	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/aws/aws-sdk-go/service/licensemanager"
+	"github.com/aws/aws-sdk-go/service/macie2"
 	"github.com/aws/aws-sdk-go/service/mediaconnect"
 	"github.com/aws/aws-sdk-go/service/mediaconvert"
 	"github.com/aws/aws-sdk-go/service/medialive"
@@ -87,6 +88,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/aws/aws-sdk-go/service/resourcegroups"
 	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/route53domains"
 	"github.com/aws/aws-sdk-go/service/route53resolver"
 	"github.com/aws/aws-sdk-go/service/sagemaker"
 	"github.com/aws/aws-sdk-go/service/securityhub"
@@ -95,8 +97,10 @@ import (
 	"github.com/aws/aws-sdk-go/service/sns"
 	"github.com/aws/aws-sdk-go/service/sqs"
 	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
 	"github.com/aws/aws-sdk-go/service/storagegateway"
 	"github.com/aws/aws-sdk-go/service/swf"
+	"github.com/aws/aws-sdk-go/service/timestreamwrite"
 	"github.com/aws/aws-sdk-go/service/transfer"
 	"github.com/aws/aws-sdk-go/service/waf"
 	"github.com/aws/aws-sdk-go/service/wafregional"
@@ -1255,6 +1259,23 @@ func LicensemanagerListTags(conn *licensemanager.LicenseManager, identifier stri
 	return LicensemanagerKeyValueTags(output.Tags), nil
 }
 
+// Macie2ListTags lists macie2 service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func Macie2ListTags(conn *macie2.Macie2, identifier string) (KeyValueTags, error) {
+	input := &macie2.ListTagsForResourceInput{
+		ResourceArn: aws.String(identifier),
+	}
+
+	output, err := conn.ListTagsForResource(input)
+
+	if err != nil {
+		return New(nil), err
+	}
+
+	return Macie2KeyValueTags(output.Tags), nil
+}
+
 // MediaconnectListTags lists mediaconnect service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
@@ -1528,6 +1549,23 @@ func Route53ListTags(conn *route53.Route53, identifier string, resourceType stri
 	return Route53KeyValueTags(output.ResourceTagSet.Tags), nil
 }
 
+// Route53domainsListTags lists route53domains service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func Route53domainsListTags(conn *route53domains.Route53Domains, identifier string) (KeyValueTags, error) {
+	input := &route53domains.ListTagsForDomainInput{
+		DomainName: aws.String(identifier),
+	}
+
+	output, err := conn.ListTagsForDomain(input)
+
+	if err != nil {
+		return New(nil), err
+	}
+
+	return Route53domainsKeyValueTags(output.TagList), nil
+}
+
 // Route53resolverListTags lists route53resolver service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
@@ -1665,6 +1703,24 @@ func SsmListTags(conn *ssm.SSM, identifier string, resourceType string) (KeyValu
 	return SsmKeyValueTags(output.TagList), nil
 }
 
+// SsoadminListTags lists ssoadmin service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func SsoadminListTags(conn *ssoadmin.SSOAdmin, identifier string, resourceType string) (KeyValueTags, error) {
+	input := &ssoadmin.ListTagsForResourceInput{
+		ResourceArn: aws.String(identifier),
+		InstanceArn: aws.String(resourceType),
+	}
+
+	output, err := conn.ListTagsForResource(input)
+
+	if err != nil {
+		return New(nil), err
+	}
+
+	return SsoadminKeyValueTags(output.Tags), nil
+}
+
 // StoragegatewayListTags lists storagegateway service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
@@ -1699,6 +1755,23 @@ func SwfListTags(conn *swf.SWF, identifier string) (KeyValueTags, error) {
 	return SwfKeyValueTags(output.Tags), nil
 }
 
+// TimestreamwriteListTags lists timestreamwrite service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func TimestreamwriteListTags(conn *timestreamwrite.TimestreamWrite, identifier string) (KeyValueTags, error) {
+	input := &timestreamwrite.ListTagsForResourceInput{
+		ResourceARN: aws.String(identifier),
+	}
+
+	output, err := conn.ListTagsForResource(input)
+
+	if err != nil {
+		return New(nil), err
+	}
+
+	return TimestreamwriteKeyValueTags(output.Tags), nil
+}
+
 // TransferListTags lists transfer service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
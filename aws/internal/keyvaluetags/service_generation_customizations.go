@@ -77,6 +77,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/aws/aws-sdk-go/service/licensemanager"
 	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/aws/aws-sdk-go/service/macie2"
 	"github.com/aws/aws-sdk-go/service/mediaconnect"
 	"github.com/aws/aws-sdk-go/service/mediaconvert"
 	"github.com/aws/aws-sdk-go/service/medialive"
@@ -96,6 +97,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/resourcegroups"
 	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
 	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/route53domains"
 	"github.com/aws/aws-sdk-go/service/route53resolver"
 	"github.com/aws/aws-sdk-go/service/sagemaker"
 	"github.com/aws/aws-sdk-go/service/secretsmanager"
@@ -105,9 +107,11 @@ import (
 	"github.com/aws/aws-sdk-go/service/sns"
 	"github.com/aws/aws-sdk-go/service/sqs"
 	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
 	"github.com/aws/aws-sdk-go/service/storagegateway"
 	"github.com/aws/aws-sdk-go/service/swf"
 	"github.com/aws/aws-sdk-go/service/synthetics"
+	"github.com/aws/aws-sdk-go/service/timestreamwrite"
 	"github.com/aws/aws-sdk-go/service/transfer"
 	"github.com/aws/aws-sdk-go/service/waf"
 	"github.com/aws/aws-sdk-go/service/wafregional"
@@ -266,6 +270,8 @@ func ServiceClientType(serviceName string) string {
 		funcType = reflect.TypeOf(licensemanager.New)
 	case "lightsail":
 		funcType = reflect.TypeOf(lightsail.New)
+	case "macie2":
+		funcType = reflect.TypeOf(macie2.New)
 	case "mediaconnect":
 		funcType = reflect.TypeOf(mediaconnect.New)
 	case "mediaconvert":
@@ -304,6 +310,8 @@ func ServiceClientType(serviceName string) string {
 		funcType = reflect.TypeOf(resourcegroupstaggingapi.New)
 	case "route53":
 		funcType = reflect.TypeOf(route53.New)
+	case "route53domains":
+		funcType = reflect.TypeOf(route53domains.New)
 	case "route53resolver":
 		funcType = reflect.TypeOf(route53resolver.New)
 	case "sagemaker":
@@ -322,12 +330,16 @@ func ServiceClientType(serviceName string) string {
 		funcType = reflect.TypeOf(sqs.New)
 	case "ssm":
 		funcType = reflect.TypeOf(ssm.New)
+	case "ssoadmin":
+		funcType = reflect.TypeOf(ssoadmin.New)
 	case "storagegateway":
 		funcType = reflect.TypeOf(storagegateway.New)
 	case "swf":
 		funcType = reflect.TypeOf(swf.New)
 	case "synthetics":
 		funcType = reflect.TypeOf(synthetics.New)
+	case "timestreamwrite":
+		funcType = reflect.TypeOf(timestreamwrite.New)
 	case "transfer":
 		funcType = reflect.TypeOf(transfer.New)
 	case "waf":
@@ -406,6 +418,8 @@ func ServiceListTagsFunction(serviceName string) string {
 		return "DescribeTags"
 	case "resourcegroups":
 		return "GetTags"
+	case "route53domains":
+		return "ListTagsForDomain"
 	case "sagemaker":
 		return "ListTags"
 	case "sqs":
@@ -499,6 +513,8 @@ func ServiceListTagsOutputTagsField(serviceName string) string {
 		return "TagList"
 	case "route53":
 		return "ResourceTagSet.Tags"
+	case "route53domains":
+		return "TagList"
 	case "ssm":
 		return "TagList"
 	case "waf":
@@ -601,6 +617,8 @@ func ServiceTagFunction(serviceName string) string {
 		return "Tag"
 	case "route53":
 		return "ChangeTagsForResource"
+	case "route53domains":
+		return "UpdateTagsForDomain"
 	case "sagemaker":
 		return "AddTags"
 	case "sqs":
@@ -715,6 +733,8 @@ func ServiceTagInputIdentifierField(serviceName string) string {
 		return "Arn"
 	case "route53":
 		return "ResourceId"
+	case "route53domains":
+		return "DomainName"
 	case "secretsmanager":
 		return "SecretId"
 	case "servicediscovery":
@@ -725,6 +745,8 @@ func ServiceTagInputIdentifierField(serviceName string) string {
 		return "ResourceId"
 	case "storagegateway":
 		return "ResourceARN"
+	case "timestreamwrite":
+		return "ResourceARN"
 	case "transfer":
 		return "Arn"
 	case "waf":
@@ -773,6 +795,8 @@ func ServiceTagInputTagsField(serviceName string) string {
 		return "TagsModel"
 	case "route53":
 		return "AddTags"
+	case "route53domains":
+		return "TagsToUpdate"
 	default:
 		return "Tags"
 	}
@@ -820,6 +844,8 @@ func ServiceTagResourceTypeField(serviceName string) string {
 		return "ResourceType"
 	case "ssm":
 		return "ResourceType"
+	case "ssoadmin":
+		return "InstanceArn"
 	default:
 		return ""
 	}
@@ -952,6 +978,8 @@ func ServiceUntagFunction(serviceName string) string {
 		return "Untag"
 	case "route53":
 		return "ChangeTagsForResource"
+	case "route53domains":
+		return "DeleteTagsForDomain"
 	case "sagemaker":
 		return "DeleteTags"
 	case "sqs":
@@ -1028,6 +1056,8 @@ func ServiceUntagInputTagsField(serviceName string) string {
 		return "Keys"
 	case "route53":
 		return "RemoveTagKeys"
+	case "route53domains":
+		return "TagsToDelete"
 	default:
 		return "TagKeys"
 	}
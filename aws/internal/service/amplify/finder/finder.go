@@ -0,0 +1,109 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/amplify"
+)
+
+// AppByID returns the App corresponding to the specified ID.
+// Returns nil if no App is found.
+func AppByID(conn *amplify.Amplify, id string) (*amplify.App, error) {
+	input := &amplify.GetAppInput{
+		AppId: aws.String(id),
+	}
+
+	output, err := conn.GetApp(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.App, nil
+}
+
+// BackendEnvironmentByAppIDAndEnvironmentName returns the Backend Environment corresponding to the specified App ID and environment name.
+// Returns nil if no Backend Environment is found.
+func BackendEnvironmentByAppIDAndEnvironmentName(conn *amplify.Amplify, appID, environmentName string) (*amplify.BackendEnvironment, error) {
+	input := &amplify.GetBackendEnvironmentInput{
+		AppId:           aws.String(appID),
+		EnvironmentName: aws.String(environmentName),
+	}
+
+	output, err := conn.GetBackendEnvironment(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.BackendEnvironment, nil
+}
+
+// BranchByAppIDAndBranchName returns the Branch corresponding to the specified App ID and branch name.
+// Returns nil if no Branch is found.
+func BranchByAppIDAndBranchName(conn *amplify.Amplify, appID, branchName string) (*amplify.Branch, error) {
+	input := &amplify.GetBranchInput{
+		AppId:      aws.String(appID),
+		BranchName: aws.String(branchName),
+	}
+
+	output, err := conn.GetBranch(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.Branch, nil
+}
+
+// DomainAssociationByAppIDAndDomainName returns the Domain Association corresponding to the specified App ID and domain name.
+// Returns nil if no Domain Association is found.
+func DomainAssociationByAppIDAndDomainName(conn *amplify.Amplify, appID, domainName string) (*amplify.DomainAssociation, error) {
+	input := &amplify.GetDomainAssociationInput{
+		AppId:      aws.String(appID),
+		DomainName: aws.String(domainName),
+	}
+
+	output, err := conn.GetDomainAssociation(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.DomainAssociation, nil
+}
+
+// WebhookByID returns the Webhook corresponding to the specified ID.
+// Returns nil if no Webhook is found.
+func WebhookByID(conn *amplify.Amplify, id string) (*amplify.Webhook, error) {
+	input := &amplify.GetWebhookInput{
+		WebhookId: aws.String(id),
+	}
+
+	output, err := conn.GetWebhook(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.Webhook, nil
+}
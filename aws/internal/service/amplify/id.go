@@ -0,0 +1,61 @@
+package amplify
+
+import (
+	"fmt"
+	"strings"
+)
+
+const backendEnvironmentIDSeparator = "/"
+
+func BackendEnvironmentCreateID(appID, environmentName string) string {
+	parts := []string{appID, environmentName}
+	id := strings.Join(parts, backendEnvironmentIDSeparator)
+	return id
+}
+
+func BackendEnvironmentParseID(id string) (string, string, error) {
+	parts := strings.Split(id, backendEnvironmentIDSeparator)
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "",
+		fmt.Errorf("unexpected format for ID (%q), expected app-id"+backendEnvironmentIDSeparator+"environment-name", id)
+}
+
+const branchIDSeparator = "/"
+
+func BranchCreateID(appID, branchName string) string {
+	parts := []string{appID, branchName}
+	id := strings.Join(parts, branchIDSeparator)
+	return id
+}
+
+// BranchParseID splits the ID on the first separator only as branch names may themselves contain "/".
+func BranchParseID(id string) (string, string, error) {
+	parts := strings.SplitN(id, branchIDSeparator, 2)
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "",
+		fmt.Errorf("unexpected format for ID (%q), expected app-id"+branchIDSeparator+"branch-name", id)
+}
+
+const domainAssociationIDSeparator = "/"
+
+func DomainAssociationCreateID(appID, domainName string) string {
+	parts := []string{appID, domainName}
+	id := strings.Join(parts, domainAssociationIDSeparator)
+	return id
+}
+
+func DomainAssociationParseID(id string) (string, string, error) {
+	parts := strings.Split(id, domainAssociationIDSeparator)
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "",
+		fmt.Errorf("unexpected format for ID (%q), expected app-id"+domainAssociationIDSeparator+"domain-name", id)
+}
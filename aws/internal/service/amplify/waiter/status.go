@@ -0,0 +1,35 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/amplify"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/amplify/finder"
+)
+
+const (
+	DomainAssociationStatusNotFound = "NotFound"
+	DomainAssociationStatusUnknown  = "Unknown"
+)
+
+// DomainAssociationStatus fetches the Domain Association and its Status
+func DomainAssociationStatus(conn *amplify.Amplify, appID, domainName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		domainAssociation, err := finder.DomainAssociationByAppIDAndDomainName(conn, appID, domainName)
+
+		if tfawserr.ErrCodeEquals(err, amplify.ErrCodeNotFoundException) {
+			return nil, DomainAssociationStatusNotFound, nil
+		}
+
+		if err != nil {
+			return nil, DomainAssociationStatusUnknown, err
+		}
+
+		if domainAssociation == nil {
+			return nil, DomainAssociationStatusNotFound, nil
+		}
+
+		return domainAssociation, aws.StringValue(domainAssociation.DomainStatus), nil
+	}
+}
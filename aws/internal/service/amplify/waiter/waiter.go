@@ -0,0 +1,67 @@
+package waiter
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/amplify"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// Maximum amount of time to wait for a Domain Association to be created
+	DomainAssociationCreatedTimeout = 5 * time.Minute
+
+	// Maximum amount of time to wait for a Domain Association's certificate to be verified
+	DomainAssociationVerifiedTimeout = 15 * time.Minute
+)
+
+// DomainAssociationCreated waits for a Domain Association to reach a state in which
+// its certificate verification DNS record is available
+func DomainAssociationCreated(conn *amplify.Amplify, appID, domainName string) (*amplify.DomainAssociation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{amplify.DomainStatusCreating, amplify.DomainStatusInProgress, amplify.DomainStatusRequestingCertificate},
+		Target:  []string{amplify.DomainStatusPendingVerification, amplify.DomainStatusPendingDeployment, amplify.DomainStatusAvailable},
+		Refresh: DomainAssociationStatus(conn, appID, domainName),
+		Timeout: DomainAssociationCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*amplify.DomainAssociation); ok {
+		return v, domainAssociationStatusError(v, err)
+	}
+
+	return nil, err
+}
+
+// DomainAssociationVerified waits for a Domain Association's certificate to be verified
+func DomainAssociationVerified(conn *amplify.Amplify, appID, domainName string) (*amplify.DomainAssociation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{amplify.DomainStatusUpdating, amplify.DomainStatusInProgress, amplify.DomainStatusPendingVerification},
+		Target:  []string{amplify.DomainStatusPendingDeployment, amplify.DomainStatusAvailable},
+		Refresh: DomainAssociationStatus(conn, appID, domainName),
+		Timeout: DomainAssociationVerifiedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*amplify.DomainAssociation); ok {
+		return v, domainAssociationStatusError(v, err)
+	}
+
+	return nil, err
+}
+
+// domainAssociationStatusError adds the reason for a Domain Association in the FAILED state to an error.
+func domainAssociationStatusError(domainAssociation *amplify.DomainAssociation, err error) error {
+	var unexpectedStateErr *resource.UnexpectedStateError
+
+	if errors.As(err, &unexpectedStateErr) && aws.StringValue(domainAssociation.DomainStatus) == amplify.DomainStatusFailed {
+		return fmt.Errorf("%w: %s", err, aws.StringValue(domainAssociation.StatusReason))
+	}
+
+	return err
+}
@@ -0,0 +1,173 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appstream"
+)
+
+// FleetByName returns the Fleet corresponding to the specified name.
+// Returns nil if no Fleet is found.
+func FleetByName(conn *appstream.AppStream, name string) (*appstream.Fleet, error) {
+	input := &appstream.DescribeFleetsInput{
+		Names: aws.StringSlice([]string{name}),
+	}
+
+	output, err := conn.DescribeFleets(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Fleets) == 0 {
+		return nil, nil
+	}
+
+	return output.Fleets[0], nil
+}
+
+// FleetStackAssociationByFleetNameAndStackName returns the name of the Stack associated with the specified Fleet.
+// Returns nil if the Stack is not associated with the Fleet.
+func FleetStackAssociationByFleetNameAndStackName(conn *appstream.AppStream, fleetName, stackName string) (*string, error) {
+	input := &appstream.ListAssociatedStacksInput{
+		FleetName: aws.String(fleetName),
+	}
+
+	for {
+		output, err := conn.ListAssociatedStacks(input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if output == nil {
+			break
+		}
+
+		for _, name := range output.Names {
+			if aws.StringValue(name) == stackName {
+				return name, nil
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return nil, nil
+}
+
+// ImageBuilderByName returns the Image Builder corresponding to the specified name.
+// Returns nil if no Image Builder is found.
+func ImageBuilderByName(conn *appstream.AppStream, name string) (*appstream.ImageBuilder, error) {
+	input := &appstream.DescribeImageBuildersInput{
+		Names: aws.StringSlice([]string{name}),
+	}
+
+	output, err := conn.DescribeImageBuilders(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.ImageBuilders) == 0 {
+		return nil, nil
+	}
+
+	return output.ImageBuilders[0], nil
+}
+
+// StackByName returns the Stack corresponding to the specified name.
+// Returns nil if no Stack is found.
+func StackByName(conn *appstream.AppStream, name string) (*appstream.Stack, error) {
+	input := &appstream.DescribeStacksInput{
+		Names: aws.StringSlice([]string{name}),
+	}
+
+	output, err := conn.DescribeStacks(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Stacks) == 0 {
+		return nil, nil
+	}
+
+	return output.Stacks[0], nil
+}
+
+// UserByNameAndAuthenticationType returns the User corresponding to the specified user name and authentication type.
+// Returns nil if no User is found.
+func UserByNameAndAuthenticationType(conn *appstream.AppStream, userName, authenticationType string) (*appstream.User, error) {
+	input := &appstream.DescribeUsersInput{
+		AuthenticationType: aws.String(authenticationType),
+	}
+
+	for {
+		output, err := conn.DescribeUsers(input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if output == nil {
+			break
+		}
+
+		for _, user := range output.Users {
+			if aws.StringValue(user.UserName) == userName {
+				return user, nil
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return nil, nil
+}
+
+// UserStackAssociationByUserNameAuthenticationTypeAndStackName returns the User Stack Association corresponding to the specified
+// user name, authentication type and stack name.
+// Returns nil if no User Stack Association is found.
+func UserStackAssociationByUserNameAuthenticationTypeAndStackName(conn *appstream.AppStream, userName, authenticationType, stackName string) (*appstream.UserStackAssociation, error) {
+	input := &appstream.DescribeUserStackAssociationsInput{
+		AuthenticationType: aws.String(authenticationType),
+		StackName:          aws.String(stackName),
+		UserName:           aws.String(userName),
+	}
+
+	for {
+		output, err := conn.DescribeUserStackAssociations(input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if output == nil {
+			break
+		}
+
+		for _, association := range output.UserStackAssociations {
+			if aws.StringValue(association.UserName) == userName &&
+				aws.StringValue(association.AuthenticationType) == authenticationType &&
+				aws.StringValue(association.StackName) == stackName {
+				return association, nil
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,60 @@
+package appstream
+
+import (
+	"fmt"
+	"strings"
+)
+
+const fleetStackAssociationIDSeparator = "/"
+
+func FleetStackAssociationCreateID(fleetName, stackName string) string {
+	parts := []string{fleetName, stackName}
+	id := strings.Join(parts, fleetStackAssociationIDSeparator)
+	return id
+}
+
+func FleetStackAssociationParseID(id string) (string, string, error) {
+	parts := strings.Split(id, fleetStackAssociationIDSeparator)
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "",
+		fmt.Errorf("unexpected format for ID (%q), expected fleet-name"+fleetStackAssociationIDSeparator+"stack-name", id)
+}
+
+const userIDSeparator = "/"
+
+func UserCreateID(userName, authenticationType string) string {
+	parts := []string{userName, authenticationType}
+	id := strings.Join(parts, userIDSeparator)
+	return id
+}
+
+func UserParseID(id string) (string, string, error) {
+	parts := strings.Split(id, userIDSeparator)
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "",
+		fmt.Errorf("unexpected format for ID (%q), expected user-name"+userIDSeparator+"authentication-type", id)
+}
+
+const userStackAssociationIDSeparator = "/"
+
+func UserStackAssociationCreateID(userName, authenticationType, stackName string) string {
+	parts := []string{userName, authenticationType, stackName}
+	id := strings.Join(parts, userStackAssociationIDSeparator)
+	return id
+}
+
+func UserStackAssociationParseID(id string) (string, string, string, error) {
+	parts := strings.Split(id, userStackAssociationIDSeparator)
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "",
+		fmt.Errorf("unexpected format for ID (%q), expected user-name"+userStackAssociationIDSeparator+"authentication-type"+userStackAssociationIDSeparator+"stack-name", id)
+}
@@ -0,0 +1,59 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream/finder"
+)
+
+const (
+	FleetStateNotFound = "NotFound"
+	FleetStateUnknown  = "Unknown"
+
+	ImageBuilderStateNotFound = "NotFound"
+	ImageBuilderStateUnknown  = "Unknown"
+)
+
+// FleetState fetches the Fleet and its State
+func FleetState(conn *appstream.AppStream, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		fleet, err := finder.FleetByName(conn, name)
+
+		if tfawserr.ErrCodeEquals(err, appstream.ErrCodeResourceNotFoundException) {
+			return nil, FleetStateNotFound, nil
+		}
+
+		if err != nil {
+			return nil, FleetStateUnknown, err
+		}
+
+		if fleet == nil {
+			return nil, FleetStateNotFound, nil
+		}
+
+		return fleet, aws.StringValue(fleet.State), nil
+	}
+}
+
+// ImageBuilderState fetches the Image Builder and its State
+func ImageBuilderState(conn *appstream.AppStream, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		imageBuilder, err := finder.ImageBuilderByName(conn, name)
+
+		if tfawserr.ErrCodeEquals(err, appstream.ErrCodeResourceNotFoundException) {
+			return nil, ImageBuilderStateNotFound, nil
+		}
+
+		if err != nil {
+			return nil, ImageBuilderStateUnknown, err
+		}
+
+		if imageBuilder == nil {
+			return nil, ImageBuilderStateNotFound, nil
+		}
+
+		return imageBuilder, aws.StringValue(imageBuilder.State), nil
+	}
+}
@@ -0,0 +1,147 @@
+package waiter
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// Maximum amount of time to wait for a Fleet to start
+	FleetRunningTimeout = 20 * time.Minute
+
+	// Maximum amount of time to wait for a Fleet to stop
+	FleetStoppedTimeout = 20 * time.Minute
+
+	// Maximum amount of time to wait for a Fleet to be deleted
+	FleetDeletedTimeout = 10 * time.Minute
+
+	// Maximum amount of time to wait for an Image Builder to start
+	ImageBuilderRunningTimeout = 60 * time.Minute
+
+	// Maximum amount of time to wait for an Image Builder to be deleted
+	ImageBuilderDeletedTimeout = 20 * time.Minute
+)
+
+// FleetRunning waits for a Fleet to reach the RUNNING state
+func FleetRunning(conn *appstream.AppStream, name string) (*appstream.Fleet, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{appstream.FleetStateStarting},
+		Target:  []string{appstream.FleetStateRunning},
+		Refresh: FleetState(conn, name),
+		Timeout: FleetRunningTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*appstream.Fleet); ok {
+		return v, fleetStateError(v, err)
+	}
+
+	return nil, err
+}
+
+// FleetStopped waits for a Fleet to reach the STOPPED state
+func FleetStopped(conn *appstream.AppStream, name string) (*appstream.Fleet, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{appstream.FleetStateStopping},
+		Target:  []string{appstream.FleetStateStopped},
+		Refresh: FleetState(conn, name),
+		Timeout: FleetStoppedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*appstream.Fleet); ok {
+		return v, fleetStateError(v, err)
+	}
+
+	return nil, err
+}
+
+// FleetDeleted waits for a Fleet to be deleted
+func FleetDeleted(conn *appstream.AppStream, name string) (*appstream.Fleet, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: appstream.FleetState_Values(),
+		Target:  []string{},
+		Refresh: FleetState(conn, name),
+		Timeout: FleetDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*appstream.Fleet); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// ImageBuilderRunning waits for an Image Builder to reach the RUNNING state
+func ImageBuilderRunning(conn *appstream.AppStream, name string) (*appstream.ImageBuilder, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{appstream.ImageBuilderStatePending, appstream.ImageBuilderStateUpdatingAgent},
+		Target:  []string{appstream.ImageBuilderStateRunning},
+		Refresh: ImageBuilderState(conn, name),
+		Timeout: ImageBuilderRunningTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*appstream.ImageBuilder); ok {
+		return v, imageBuilderStateError(v, err)
+	}
+
+	return nil, err
+}
+
+// ImageBuilderDeleted waits for an Image Builder to be deleted
+func ImageBuilderDeleted(conn *appstream.AppStream, name string) (*appstream.ImageBuilder, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{appstream.ImageBuilderStateStopping, appstream.ImageBuilderStateDeleting},
+		Target:  []string{},
+		Refresh: ImageBuilderState(conn, name),
+		Timeout: ImageBuilderDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*appstream.ImageBuilder); ok {
+		return v, imageBuilderStateError(v, err)
+	}
+
+	return nil, err
+}
+
+// fleetStateError adds any Fleet errors to an unexpected state error.
+func fleetStateError(fleet *appstream.Fleet, err error) error {
+	var unexpectedStateErr *resource.UnexpectedStateError
+
+	if !errors.As(err, &unexpectedStateErr) || len(fleet.FleetErrors) == 0 {
+		return err
+	}
+
+	var messages []string
+
+	for _, fleetError := range fleet.FleetErrors {
+		messages = append(messages, fmt.Sprintf("%s: %s", aws.StringValue(fleetError.ErrorCode), aws.StringValue(fleetError.ErrorMessage)))
+	}
+
+	return fmt.Errorf("%w: %s", err, strings.Join(messages, ", "))
+}
+
+// imageBuilderStateError adds the reason for an Image Builder's last state change to an unexpected state error.
+func imageBuilderStateError(imageBuilder *appstream.ImageBuilder, err error) error {
+	var unexpectedStateErr *resource.UnexpectedStateError
+
+	if !errors.As(err, &unexpectedStateErr) || imageBuilder.StateChangeReason == nil {
+		return err
+	}
+
+	return fmt.Errorf("%w: %s", err, aws.StringValue(imageBuilder.StateChangeReason.Message))
+}
@@ -0,0 +1,32 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+)
+
+// InstanceRefreshByID returns the Instance Refresh of the specified Auto Scaling Group corresponding to the specified ID.
+// Returns nil if no Instance Refresh is found.
+func InstanceRefreshByID(conn *autoscaling.AutoScaling, asgName, id string) (*autoscaling.InstanceRefresh, error) {
+	input := &autoscaling.DescribeInstanceRefreshesInput{
+		AutoScalingGroupName: aws.String(asgName),
+		InstanceRefreshIds:   aws.StringSlice([]string{id}),
+	}
+
+	output, err := conn.DescribeInstanceRefreshes(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	for _, instanceRefresh := range output.InstanceRefreshes {
+		if aws.StringValue(instanceRefresh.InstanceRefreshId) == id {
+			return instanceRefresh, nil
+		}
+	}
+
+	return nil, nil
+}
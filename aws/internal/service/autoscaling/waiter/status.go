@@ -0,0 +1,30 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/autoscaling/finder"
+)
+
+const (
+	InstanceRefreshStatusNotFound = "NotFound"
+	InstanceRefreshStatusUnknown  = "Unknown"
+)
+
+// InstanceRefreshStatus fetches the Instance Refresh and its Status
+func InstanceRefreshStatus(conn *autoscaling.AutoScaling, asgName, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		instanceRefresh, err := finder.InstanceRefreshByID(conn, asgName, id)
+
+		if err != nil {
+			return nil, InstanceRefreshStatusUnknown, err
+		}
+
+		if instanceRefresh == nil {
+			return nil, InstanceRefreshStatusNotFound, nil
+		}
+
+		return instanceRefresh, aws.StringValue(instanceRefresh.Status), nil
+	}
+}
@@ -0,0 +1,77 @@
+package waiter
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// Maximum amount of time to wait for an Instance Refresh to be cancelled
+	InstanceRefreshCancelledTimeout = 15 * time.Minute
+)
+
+// InstanceRefreshSuccessful waits for an Instance Refresh to return Successful
+func InstanceRefreshSuccessful(conn *autoscaling.AutoScaling, asgName, id string, timeout time.Duration) (*autoscaling.InstanceRefresh, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{autoscaling.InstanceRefreshStatusPending, autoscaling.InstanceRefreshStatusInProgress},
+		Target:  []string{autoscaling.InstanceRefreshStatusSuccessful},
+		Refresh: InstanceRefreshStatus(conn, asgName, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*autoscaling.InstanceRefresh); ok {
+		return v, instanceRefreshStatusError(v, err)
+	}
+
+	return nil, err
+}
+
+// InstanceRefreshCancelled waits for an Instance Refresh to reach a terminal status after cancellation
+func InstanceRefreshCancelled(conn *autoscaling.AutoScaling, asgName, id string) (*autoscaling.InstanceRefresh, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			autoscaling.InstanceRefreshStatusPending,
+			autoscaling.InstanceRefreshStatusInProgress,
+			autoscaling.InstanceRefreshStatusCancelling,
+		},
+		Target: []string{
+			autoscaling.InstanceRefreshStatusCancelled,
+			// Failed and Successful are also acceptable outcomes of a cancellation request
+			// as the Instance Refresh may have completed before it could be cancelled.
+			autoscaling.InstanceRefreshStatusFailed,
+			autoscaling.InstanceRefreshStatusSuccessful,
+		},
+		Refresh: InstanceRefreshStatus(conn, asgName, id),
+		Timeout: InstanceRefreshCancelledTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*autoscaling.InstanceRefresh); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// instanceRefreshStatusError adds the reason for an Instance Refresh in the Failed or Cancelled status to an error.
+func instanceRefreshStatusError(instanceRefresh *autoscaling.InstanceRefresh, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	switch aws.StringValue(instanceRefresh.Status) {
+	case autoscaling.InstanceRefreshStatusFailed, autoscaling.InstanceRefreshStatusCancelled:
+		if reason := aws.StringValue(instanceRefresh.StatusReason); reason != "" {
+			return fmt.Errorf("%w: %s", err, reason)
+		}
+	}
+
+	return err
+}
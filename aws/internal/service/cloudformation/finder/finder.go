@@ -0,0 +1,59 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+)
+
+// ChangeSetByStackIDAndChangeSetName returns the Change Set corresponding to the specified stack ID and change set name.
+// Returns nil if no Change Set is found.
+func ChangeSetByStackIDAndChangeSetName(conn *cloudformation.CloudFormation, stackID, changeSetName string) (*cloudformation.DescribeChangeSetOutput, error) {
+	input := &cloudformation.DescribeChangeSetInput{
+		ChangeSetName: aws.String(changeSetName),
+		StackName:     aws.String(stackID),
+	}
+
+	output, err := conn.DescribeChangeSet(input)
+
+	if tfawserr.ErrCodeEquals(err, cloudformation.ErrCodeChangeSetNotFoundException) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// StackByID returns the Stack corresponding to the specified ID.
+// Returns nil if no Stack is found or the Stack has been deleted.
+func StackByID(conn *cloudformation.CloudFormation, id string) (*cloudformation.Stack, error) {
+	input := &cloudformation.DescribeStacksInput{
+		StackName: aws.String(id),
+	}
+
+	output, err := conn.DescribeStacks(input)
+
+	// ValidationError: Stack with id % does not exist
+	if tfawserr.ErrMessageContains(err, "ValidationError", "does not exist") {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Stacks) == 0 || output.Stacks[0] == nil {
+		return nil, nil
+	}
+
+	stack := output.Stacks[0]
+
+	if aws.StringValue(stack.StackStatus) == cloudformation.StackStatusDeleteComplete {
+		return nil, nil
+	}
+
+	return stack, nil
+}
@@ -0,0 +1,49 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudformation/finder"
+)
+
+const (
+	ChangeSetStatusNotFound = "NotFound"
+	ChangeSetStatusUnknown  = "Unknown"
+
+	StackStatusUnknown = "Unknown"
+)
+
+// ChangeSetStatus fetches the Change Set and its Status
+func ChangeSetStatus(conn *cloudformation.CloudFormation, stackID, changeSetName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		changeSet, err := finder.ChangeSetByStackIDAndChangeSetName(conn, stackID, changeSetName)
+
+		if err != nil {
+			return nil, ChangeSetStatusUnknown, err
+		}
+
+		if changeSet == nil {
+			return nil, ChangeSetStatusNotFound, nil
+		}
+
+		return changeSet, aws.StringValue(changeSet.Status), nil
+	}
+}
+
+// StackStatus fetches the Stack and its StackStatus
+func StackStatus(conn *cloudformation.CloudFormation, stackID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		stack, err := finder.StackByID(conn, stackID)
+
+		if err != nil {
+			return nil, StackStatusUnknown, err
+		}
+
+		if stack == nil {
+			return nil, "", nil
+		}
+
+		return stack, aws.StringValue(stack.StackStatus), nil
+	}
+}
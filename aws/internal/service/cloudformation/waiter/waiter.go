@@ -0,0 +1,106 @@
+package waiter
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// Maximum amount of time to wait for a Change Set to be created
+	ChangeSetCreatedTimeout = 5 * time.Minute
+)
+
+// ChangeSetCreated waits for a Change Set to be created
+func ChangeSetCreated(conn *cloudformation.CloudFormation, stackID, changeSetName string) (*cloudformation.DescribeChangeSetOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{cloudformation.ChangeSetStatusCreatePending, cloudformation.ChangeSetStatusCreateInProgress},
+		Target:  []string{cloudformation.ChangeSetStatusCreateComplete},
+		Refresh: ChangeSetStatus(conn, stackID, changeSetName),
+		Timeout: ChangeSetCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*cloudformation.DescribeChangeSetOutput); ok {
+		if aws.StringValue(v.Status) == cloudformation.ChangeSetStatusFailed {
+			return v, statusReasonError(err, aws.StringValue(v.StatusReason))
+		}
+
+		return v, err
+	}
+
+	return nil, err
+}
+
+// StackCreated waits for a Stack to be created
+func StackCreated(conn *cloudformation.CloudFormation, stackID string, timeout time.Duration) (*cloudformation.Stack, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{cloudformation.StackStatusCreateInProgress, cloudformation.StackStatusReviewInProgress},
+		Target:  []string{cloudformation.StackStatusCreateComplete},
+		Refresh: StackStatus(conn, stackID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*cloudformation.Stack); ok {
+		return v, statusReasonError(err, aws.StringValue(v.StackStatusReason))
+	}
+
+	return nil, err
+}
+
+// StackUpdated waits for a Stack to be updated
+func StackUpdated(conn *cloudformation.CloudFormation, stackID string, timeout time.Duration) (*cloudformation.Stack, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			cloudformation.StackStatusUpdateCompleteCleanupInProgress,
+			cloudformation.StackStatusUpdateInProgress,
+		},
+		Target:  []string{cloudformation.StackStatusUpdateComplete},
+		Refresh: StackStatus(conn, stackID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*cloudformation.Stack); ok {
+		return v, statusReasonError(err, aws.StringValue(v.StackStatusReason))
+	}
+
+	return nil, err
+}
+
+// StackDeleted waits for a Stack to be deleted
+func StackDeleted(conn *cloudformation.CloudFormation, stackID string, timeout time.Duration) (*cloudformation.Stack, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{cloudformation.StackStatusDeleteInProgress},
+		Target:  []string{},
+		Refresh: StackStatus(conn, stackID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*cloudformation.Stack); ok {
+		return v, statusReasonError(err, aws.StringValue(v.StackStatusReason))
+	}
+
+	return nil, err
+}
+
+// statusReasonError adds a status reason to an unexpected state error.
+func statusReasonError(err error, reason string) error {
+	var unexpectedStateErr *resource.UnexpectedStateError
+
+	if reason != "" && errors.As(err, &unexpectedStateErr) {
+		return fmt.Errorf("%w: %s", err, reason)
+	}
+
+	return err
+}
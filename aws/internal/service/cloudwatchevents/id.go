@@ -0,0 +1,95 @@
+package cloudwatchevents
+
+import (
+	"fmt"
+	"strings"
+)
+
+const DefaultEventBusName = "default"
+
+const partnerEventBusPrefix = "aws.partner/"
+
+const permissionIDSeparator = "/"
+
+func PermissionCreateID(eventBusName, statementID string) string {
+	if eventBusName == "" || eventBusName == DefaultEventBusName {
+		return statementID
+	}
+	return eventBusName + permissionIDSeparator + statementID
+}
+
+func PermissionParseID(id string) (string, string, error) {
+	parts := strings.Split(id, permissionIDSeparator)
+	if len(parts) == 1 && parts[0] != "" {
+		return DefaultEventBusName, parts[0], nil
+	}
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%q), expected <event-bus-name>"+permissionIDSeparator+"<statement-id> or <statement-id>", id)
+}
+
+const ruleIDSeparator = "/"
+
+func RuleCreateID(eventBusName, ruleName string) string {
+	if eventBusName == "" || eventBusName == DefaultEventBusName {
+		return ruleName
+	}
+	return eventBusName + ruleIDSeparator + ruleName
+}
+
+// RuleParseID parses a rule ID into its event bus name and rule name.
+// Partner event bus names (e.g. aws.partner/example.com/123) contain the separator,
+// so everything before the last separator is treated as the event bus name.
+func RuleParseID(id string) (string, string, error) {
+	parts := strings.Split(id, ruleIDSeparator)
+	if len(parts) == 1 && parts[0] != "" {
+		return DefaultEventBusName, parts[0], nil
+	}
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+	if len(parts) > 2 && strings.HasPrefix(id, partnerEventBusPrefix) {
+		i := strings.LastIndex(id, ruleIDSeparator)
+		eventBusName, ruleName := id[:i], id[i+1:]
+		if ruleName != "" {
+			return eventBusName, ruleName, nil
+		}
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%q), expected <event-bus-name>"+ruleIDSeparator+"<rule-name> or <rule-name>", id)
+}
+
+const targetIDSeparator = "-"
+const targetImportIDSeparator = "/"
+
+func TargetCreateID(eventBusName, ruleName, targetID string) string {
+	id := ruleName + targetIDSeparator + targetID
+	if eventBusName != "" && eventBusName != DefaultEventBusName {
+		id = eventBusName + targetIDSeparator + id
+	}
+	return id
+}
+
+// TargetParseImportID parses a target import ID into its event bus name, rule name and target ID.
+// Partner event bus names contain the separator, so for those the last two parts are the rule name and target ID.
+func TargetParseImportID(id string) (string, string, string, error) {
+	parts := strings.Split(id, targetImportIDSeparator)
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return DefaultEventBusName, parts[0], parts[1], nil
+	}
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+	if len(parts) > 3 && strings.HasPrefix(id, partnerEventBusPrefix) {
+		n := len(parts)
+		eventBusName := strings.Join(parts[:n-2], targetImportIDSeparator)
+		ruleName, targetID := parts[n-2], parts[n-1]
+		if ruleName != "" && targetID != "" {
+			return eventBusName, ruleName, targetID, nil
+		}
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%q), expected <event-bus-name>"+targetImportIDSeparator+"<rule-name>"+targetImportIDSeparator+"<target-id> or <rule-name>"+targetImportIDSeparator+"<target-id>", id)
+}
@@ -0,0 +1,217 @@
+package cloudwatchevents_test
+
+import (
+	"testing"
+
+	tfevents "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudwatchevents"
+)
+
+func TestPermissionParseID(t *testing.T) {
+	testCases := []struct {
+		TestName             string
+		InputID              string
+		ExpectedError        bool
+		ExpectedEventBusName string
+		ExpectedStatementID  string
+	}{
+		{
+			TestName:      "empty ID",
+			InputID:       "",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "single separator",
+			InputID:       "/",
+			ExpectedError: true,
+		},
+		{
+			TestName:             "statement ID",
+			InputID:              "statement",
+			ExpectedEventBusName: tfevents.DefaultEventBusName,
+			ExpectedStatementID:  "statement",
+		},
+		{
+			TestName:             "event bus name and statement ID",
+			InputID:              "my-bus/statement",
+			ExpectedEventBusName: "my-bus",
+			ExpectedStatementID:  "statement",
+		},
+		{
+			TestName:      "too many parts",
+			InputID:       "a/b/c",
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotEventBusName, gotStatementID, err := tfevents.PermissionParseID(testCase.InputID)
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if gotEventBusName != testCase.ExpectedEventBusName {
+				t.Errorf("got event bus name %s, expected %s", gotEventBusName, testCase.ExpectedEventBusName)
+			}
+
+			if gotStatementID != testCase.ExpectedStatementID {
+				t.Errorf("got statement ID %s, expected %s", gotStatementID, testCase.ExpectedStatementID)
+			}
+		})
+	}
+}
+
+func TestRuleParseID(t *testing.T) {
+	testCases := []struct {
+		TestName             string
+		InputID              string
+		ExpectedError        bool
+		ExpectedEventBusName string
+		ExpectedRuleName     string
+	}{
+		{
+			TestName:      "empty ID",
+			InputID:       "",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "single separator",
+			InputID:       "/",
+			ExpectedError: true,
+		},
+		{
+			TestName:             "rule name",
+			InputID:              "my-rule",
+			ExpectedEventBusName: tfevents.DefaultEventBusName,
+			ExpectedRuleName:     "my-rule",
+		},
+		{
+			TestName:             "event bus name and rule name",
+			InputID:              "my-bus/my-rule",
+			ExpectedEventBusName: "my-bus",
+			ExpectedRuleName:     "my-rule",
+		},
+		{
+			TestName:             "partner event bus name and rule name",
+			InputID:              "aws.partner/example.com/123/my-rule",
+			ExpectedEventBusName: "aws.partner/example.com/123",
+			ExpectedRuleName:     "my-rule",
+		},
+		{
+			TestName:      "too many parts",
+			InputID:       "a/b/c",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "partner event bus name without rule name",
+			InputID:       "aws.partner/example.com/123/",
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotEventBusName, gotRuleName, err := tfevents.RuleParseID(testCase.InputID)
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if gotEventBusName != testCase.ExpectedEventBusName {
+				t.Errorf("got event bus name %s, expected %s", gotEventBusName, testCase.ExpectedEventBusName)
+			}
+
+			if gotRuleName != testCase.ExpectedRuleName {
+				t.Errorf("got rule name %s, expected %s", gotRuleName, testCase.ExpectedRuleName)
+			}
+		})
+	}
+}
+
+func TestTargetParseImportID(t *testing.T) {
+	testCases := []struct {
+		TestName             string
+		InputID              string
+		ExpectedError        bool
+		ExpectedEventBusName string
+		ExpectedRuleName     string
+		ExpectedTargetID     string
+	}{
+		{
+			TestName:      "empty ID",
+			InputID:       "",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "single part",
+			InputID:       "my-rule",
+			ExpectedError: true,
+		},
+		{
+			TestName:             "rule name and target ID",
+			InputID:              "my-rule/my-target",
+			ExpectedEventBusName: tfevents.DefaultEventBusName,
+			ExpectedRuleName:     "my-rule",
+			ExpectedTargetID:     "my-target",
+		},
+		{
+			TestName:             "event bus name, rule name and target ID",
+			InputID:              "my-bus/my-rule/my-target",
+			ExpectedEventBusName: "my-bus",
+			ExpectedRuleName:     "my-rule",
+			ExpectedTargetID:     "my-target",
+		},
+		{
+			TestName:             "partner event bus name, rule name and target ID",
+			InputID:              "aws.partner/example.com/123/my-rule/my-target",
+			ExpectedEventBusName: "aws.partner/example.com/123",
+			ExpectedRuleName:     "my-rule",
+			ExpectedTargetID:     "my-target",
+		},
+		{
+			TestName:      "empty target ID",
+			InputID:       "my-bus/my-rule/",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "too many parts",
+			InputID:       "a/b/c/d",
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotEventBusName, gotRuleName, gotTargetID, err := tfevents.TargetParseImportID(testCase.InputID)
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if gotEventBusName != testCase.ExpectedEventBusName {
+				t.Errorf("got event bus name %s, expected %s", gotEventBusName, testCase.ExpectedEventBusName)
+			}
+
+			if gotRuleName != testCase.ExpectedRuleName {
+				t.Errorf("got rule name %s, expected %s", gotRuleName, testCase.ExpectedRuleName)
+			}
+
+			if gotTargetID != testCase.ExpectedTargetID {
+				t.Errorf("got target ID %s, expected %s", gotTargetID, testCase.ExpectedTargetID)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kinesisanalyticsv2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// ApplicationStatus NotFound
+	ApplicationStatusNotFound = "NotFound"
+
+	// ApplicationStatus Unknown
+	ApplicationStatusUnknown = "Unknown"
+)
+
+// ApplicationStatus fetches the ApplicationDetail and its Status
+func ApplicationStatus(conn *kinesisanalyticsv2.KinesisAnalyticsV2, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &kinesisanalyticsv2.DescribeApplicationInput{
+			ApplicationName: aws.String(name),
+		}
+
+		output, err := conn.DescribeApplication(input)
+
+		if tfawserr.ErrCodeEquals(err, kinesisanalyticsv2.ErrCodeResourceNotFoundException) {
+			return nil, ApplicationStatusNotFound, nil
+		}
+
+		if err != nil {
+			return nil, ApplicationStatusUnknown, err
+		}
+
+		if output == nil || output.ApplicationDetail == nil {
+			return nil, ApplicationStatusNotFound, nil
+		}
+
+		return output.ApplicationDetail, aws.StringValue(output.ApplicationDetail.ApplicationStatus), nil
+	}
+}
@@ -0,0 +1,7 @@
+package macie2
+
+const (
+	// ErrMessageMacieNotEnabled is returned with an AccessDeniedException when
+	// Amazon Macie isn't enabled for the account
+	ErrMessageMacieNotEnabled = "Macie is not enabled"
+)
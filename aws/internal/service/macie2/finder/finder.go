@@ -0,0 +1,158 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+)
+
+// ClassificationJobByID returns the classification job corresponding to the specified ID.
+func ClassificationJobByID(conn *macie2.Macie2, id string) (*macie2.DescribeClassificationJobOutput, error) {
+	input := &macie2.DescribeClassificationJobInput{
+		JobId: aws.String(id),
+	}
+
+	output, err := conn.DescribeClassificationJob(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// CustomDataIdentifierByID returns the custom data identifier corresponding to the specified ID.
+// Returns nil if no custom data identifier is found or it has been deleted.
+func CustomDataIdentifierByID(conn *macie2.Macie2, id string) (*macie2.GetCustomDataIdentifierOutput, error) {
+	input := &macie2.GetCustomDataIdentifierInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.GetCustomDataIdentifier(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || aws.BoolValue(output.Deleted) {
+		return nil, nil
+	}
+
+	return output, nil
+}
+
+// FindingsFilterByID returns the findings filter corresponding to the specified ID.
+func FindingsFilterByID(conn *macie2.Macie2, id string) (*macie2.GetFindingsFilterOutput, error) {
+	input := &macie2.GetFindingsFilterInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.GetFindingsFilter(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// InvitationByAdministratorAccountID returns the pending invitation from the specified administrator account.
+// Returns nil if no invitation is found.
+func InvitationByAdministratorAccountID(conn *macie2.Macie2, administratorAccountID string) (*macie2.Invitation, error) {
+	input := &macie2.ListInvitationsInput{}
+	var result *macie2.Invitation
+
+	err := conn.ListInvitationsPages(input, func(page *macie2.ListInvitationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, invitation := range page.Invitations {
+			if invitation == nil {
+				continue
+			}
+
+			if aws.StringValue(invitation.AccountId) == administratorAccountID {
+				result = invitation
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	return result, err
+}
+
+// MacieSession returns the Amazon Macie status and configuration settings for the current account.
+func MacieSession(conn *macie2.Macie2) (*macie2.GetMacieSessionOutput, error) {
+	input := &macie2.GetMacieSessionInput{}
+
+	output, err := conn.GetMacieSession(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// MasterAccount returns the administrator account that the current account is associated with.
+// Returns nil if the current account isn't associated with an administrator account.
+func MasterAccount(conn *macie2.Macie2) (*macie2.Invitation, error) {
+	input := &macie2.GetMasterAccountInput{}
+
+	output, err := conn.GetMasterAccount(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.Master, nil
+}
+
+// MemberByAccountID returns the member account corresponding to the specified account ID.
+func MemberByAccountID(conn *macie2.Macie2, accountID string) (*macie2.GetMemberOutput, error) {
+	input := &macie2.GetMemberInput{
+		Id: aws.String(accountID),
+	}
+
+	output, err := conn.GetMember(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// OrganizationAdminAccountByID returns the delegated administrator account corresponding to the specified account ID.
+// Returns nil if no delegated administrator account is found.
+func OrganizationAdminAccountByID(conn *macie2.Macie2, adminAccountID string) (*macie2.AdminAccount, error) {
+	input := &macie2.ListOrganizationAdminAccountsInput{}
+	var result *macie2.AdminAccount
+
+	err := conn.ListOrganizationAdminAccountsPages(input, func(page *macie2.ListOrganizationAdminAccountsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, adminAccount := range page.AdminAccounts {
+			if adminAccount == nil {
+				continue
+			}
+
+			if aws.StringValue(adminAccount.AccountId) == adminAccountID {
+				result = adminAccount
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	return result, err
+}
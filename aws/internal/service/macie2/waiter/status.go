@@ -0,0 +1,50 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/macie2/finder"
+)
+
+const (
+	// AdminStatus NotFound
+	AdminStatusNotFound = "NotFound"
+
+	// AdminStatus Unknown
+	AdminStatusUnknown = "Unknown"
+)
+
+// OrganizationAdminAccountStatus fetches the AdminAccount and its Status
+func OrganizationAdminAccountStatus(conn *macie2.Macie2, adminAccountID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		adminAccount, err := finder.OrganizationAdminAccountByID(conn, adminAccountID)
+
+		if err != nil {
+			return nil, AdminStatusUnknown, err
+		}
+
+		if adminAccount == nil {
+			return adminAccount, AdminStatusNotFound, nil
+		}
+
+		return adminAccount, aws.StringValue(adminAccount.Status), nil
+	}
+}
+
+// MemberRelationshipStatus fetches the Member and its RelationshipStatus
+func MemberRelationshipStatus(conn *macie2.Macie2, accountID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		member, err := finder.MemberByAccountID(conn, accountID)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if member == nil {
+			return nil, "", nil
+		}
+
+		return member, aws.StringValue(member.RelationshipStatus), nil
+	}
+}
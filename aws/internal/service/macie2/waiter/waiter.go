@@ -0,0 +1,73 @@
+package waiter
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// Maximum amount of time to wait for an AdminAccount to return Enabled
+	OrganizationAdminAccountEnabledTimeout = 5 * time.Minute
+
+	// Maximum amount of time to wait for an AdminAccount to return NotFound
+	OrganizationAdminAccountNotFoundTimeout = 5 * time.Minute
+
+	// Maximum amount of time to wait for a Member to return Invited
+	MemberInvitedTimeout = 5 * time.Minute
+)
+
+// OrganizationAdminAccountEnabled waits for an AdminAccount to return Enabled
+func OrganizationAdminAccountEnabled(conn *macie2.Macie2, adminAccountID string) (*macie2.AdminAccount, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{AdminStatusNotFound},
+		Target:  []string{macie2.AdminStatusEnabled},
+		Refresh: OrganizationAdminAccountStatus(conn, adminAccountID),
+		Timeout: OrganizationAdminAccountEnabledTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*macie2.AdminAccount); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// OrganizationAdminAccountNotFound waits for an AdminAccount to return NotFound
+func OrganizationAdminAccountNotFound(conn *macie2.Macie2, adminAccountID string) (*macie2.AdminAccount, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{macie2.AdminStatusDisablingInProgress},
+		Target:  []string{AdminStatusNotFound},
+		Refresh: OrganizationAdminAccountStatus(conn, adminAccountID),
+		Timeout: OrganizationAdminAccountNotFoundTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*macie2.AdminAccount); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// MemberInvited waits for a Member to return Invited
+func MemberInvited(conn *macie2.Macie2, accountID string) (*macie2.GetMemberOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{macie2.RelationshipStatusCreated, macie2.RelationshipStatusEmailVerificationInProgress},
+		Target:  []string{macie2.RelationshipStatusInvited, macie2.RelationshipStatusEnabled, macie2.RelationshipStatusPaused},
+		Refresh: MemberRelationshipStatus(conn, accountID),
+		Timeout: MemberInvitedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*macie2.GetMemberOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,181 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+)
+
+// GlobalNetworkByID returns the Global Network corresponding to the specified ID.
+// Returns nil if no Global Network is found.
+func GlobalNetworkByID(conn *networkmanager.NetworkManager, globalNetworkID string) (*networkmanager.GlobalNetwork, error) {
+	input := &networkmanager.DescribeGlobalNetworksInput{
+		GlobalNetworkIds: aws.StringSlice([]string{globalNetworkID}),
+	}
+
+	output, err := conn.DescribeGlobalNetworks(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, globalNetwork := range output.GlobalNetworks {
+		if globalNetwork == nil {
+			continue
+		}
+
+		if aws.StringValue(globalNetwork.GlobalNetworkId) == globalNetworkID {
+			return globalNetwork, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// SiteByID returns the Site corresponding to the specified Global Network ID and Site ID.
+// Returns nil if no Site is found.
+func SiteByID(conn *networkmanager.NetworkManager, globalNetworkID, siteID string) (*networkmanager.Site, error) {
+	input := &networkmanager.GetSitesInput{
+		GlobalNetworkId: aws.String(globalNetworkID),
+		SiteIds:         aws.StringSlice([]string{siteID}),
+	}
+
+	output, err := conn.GetSites(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, site := range output.Sites {
+		if site == nil {
+			continue
+		}
+
+		if aws.StringValue(site.SiteId) == siteID {
+			return site, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// DeviceByID returns the Device corresponding to the specified Global Network ID and Device ID.
+// Returns nil if no Device is found.
+func DeviceByID(conn *networkmanager.NetworkManager, globalNetworkID, deviceID string) (*networkmanager.Device, error) {
+	input := &networkmanager.GetDevicesInput{
+		DeviceIds:       aws.StringSlice([]string{deviceID}),
+		GlobalNetworkId: aws.String(globalNetworkID),
+	}
+
+	output, err := conn.GetDevices(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, device := range output.Devices {
+		if device == nil {
+			continue
+		}
+
+		if aws.StringValue(device.DeviceId) == deviceID {
+			return device, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// LinkByID returns the Link corresponding to the specified Global Network ID and Link ID.
+// Returns nil if no Link is found.
+func LinkByID(conn *networkmanager.NetworkManager, globalNetworkID, linkID string) (*networkmanager.Link, error) {
+	input := &networkmanager.GetLinksInput{
+		GlobalNetworkId: aws.String(globalNetworkID),
+		LinkIds:         aws.StringSlice([]string{linkID}),
+	}
+
+	output, err := conn.GetLinks(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, link := range output.Links {
+		if link == nil {
+			continue
+		}
+
+		if aws.StringValue(link.LinkId) == linkID {
+			return link, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// LinkAssociation returns the Link Association corresponding to the specified Global Network ID, Link ID and Device ID.
+// Returns nil if no Link Association is found or the Link Association has been deleted.
+func LinkAssociation(conn *networkmanager.NetworkManager, globalNetworkID, linkID, deviceID string) (*networkmanager.LinkAssociation, error) {
+	input := &networkmanager.GetLinkAssociationsInput{
+		DeviceId:        aws.String(deviceID),
+		GlobalNetworkId: aws.String(globalNetworkID),
+		LinkId:          aws.String(linkID),
+	}
+
+	var result *networkmanager.LinkAssociation
+
+	err := conn.GetLinkAssociationsPages(input, func(page *networkmanager.GetLinkAssociationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, linkAssociation := range page.LinkAssociations {
+			if linkAssociation == nil {
+				continue
+			}
+
+			if aws.StringValue(linkAssociation.LinkAssociationState) == networkmanager.LinkAssociationStateDeleted {
+				continue
+			}
+
+			if aws.StringValue(linkAssociation.LinkId) == linkID && aws.StringValue(linkAssociation.DeviceId) == deviceID {
+				result = linkAssociation
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	return result, err
+}
+
+// TransitGatewayRegistration returns the Transit Gateway Registration corresponding to the specified Global Network ID and Transit Gateway ARN.
+// Returns nil if no Transit Gateway Registration is found or the Transit Gateway Registration has been deleted.
+func TransitGatewayRegistration(conn *networkmanager.NetworkManager, globalNetworkID, transitGatewayARN string) (*networkmanager.TransitGatewayRegistration, error) {
+	input := &networkmanager.GetTransitGatewayRegistrationsInput{
+		GlobalNetworkId:    aws.String(globalNetworkID),
+		TransitGatewayArns: aws.StringSlice([]string{transitGatewayARN}),
+	}
+
+	output, err := conn.GetTransitGatewayRegistrations(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, transitGatewayRegistration := range output.TransitGatewayRegistrations {
+		if transitGatewayRegistration == nil {
+			continue
+		}
+
+		if transitGatewayRegistration.State != nil && aws.StringValue(transitGatewayRegistration.State.Code) == networkmanager.TransitGatewayRegistrationStateDeleted {
+			continue
+		}
+
+		if aws.StringValue(transitGatewayRegistration.TransitGatewayArn) == transitGatewayARN {
+			return transitGatewayRegistration, nil
+		}
+	}
+
+	return nil, nil
+}
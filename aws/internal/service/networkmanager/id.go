@@ -0,0 +1,44 @@
+package networkmanager
+
+import (
+	"fmt"
+	"strings"
+)
+
+const linkAssociationIDSeparator = ","
+
+func LinkAssociationCreateID(globalNetworkID, linkID, deviceID string) string {
+	parts := []string{globalNetworkID, linkID, deviceID}
+	id := strings.Join(parts, linkAssociationIDSeparator)
+	return id
+}
+
+func LinkAssociationParseID(id string) (string, string, string, error) {
+	parts := strings.Split(id, linkAssociationIDSeparator)
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "",
+		fmt.Errorf("unexpected format for ID (%q), expected global-network-id"+linkAssociationIDSeparator+
+			"link-id"+linkAssociationIDSeparator+"device-id", id)
+}
+
+const transitGatewayRegistrationIDSeparator = ","
+
+func TransitGatewayRegistrationCreateID(globalNetworkID, transitGatewayARN string) string {
+	parts := []string{globalNetworkID, transitGatewayARN}
+	id := strings.Join(parts, transitGatewayRegistrationIDSeparator)
+	return id
+}
+
+func TransitGatewayRegistrationParseID(id string) (string, string, error) {
+	parts := strings.Split(id, transitGatewayRegistrationIDSeparator)
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "",
+		fmt.Errorf("unexpected format for ID (%q), expected global-network-id"+transitGatewayRegistrationIDSeparator+
+			"transit-gateway-arn", id)
+}
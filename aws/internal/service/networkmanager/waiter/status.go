@@ -0,0 +1,155 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkmanager/finder"
+)
+
+const (
+	GlobalNetworkStatusNotFound = "NotFound"
+	GlobalNetworkStatusUnknown  = "Unknown"
+
+	SiteStatusNotFound = "NotFound"
+	SiteStatusUnknown  = "Unknown"
+
+	DeviceStatusNotFound = "NotFound"
+	DeviceStatusUnknown  = "Unknown"
+
+	LinkStatusNotFound = "NotFound"
+	LinkStatusUnknown  = "Unknown"
+
+	LinkAssociationStatusNotFound = "NotFound"
+	LinkAssociationStatusUnknown  = "Unknown"
+
+	TransitGatewayRegistrationStatusNotFound = "NotFound"
+	TransitGatewayRegistrationStatusUnknown  = "Unknown"
+)
+
+// GlobalNetworkState fetches the Global Network and its State
+func GlobalNetworkState(conn *networkmanager.NetworkManager, globalNetworkID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		globalNetwork, err := finder.GlobalNetworkByID(conn, globalNetworkID)
+
+		if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+			return nil, GlobalNetworkStatusNotFound, nil
+		}
+
+		if err != nil {
+			return nil, GlobalNetworkStatusUnknown, err
+		}
+
+		if globalNetwork == nil || globalNetwork.State == nil {
+			return nil, GlobalNetworkStatusNotFound, nil
+		}
+
+		return globalNetwork, aws.StringValue(globalNetwork.State), nil
+	}
+}
+
+// SiteState fetches the Site and its State
+func SiteState(conn *networkmanager.NetworkManager, globalNetworkID, siteID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		site, err := finder.SiteByID(conn, globalNetworkID, siteID)
+
+		if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+			return nil, SiteStatusNotFound, nil
+		}
+
+		if err != nil {
+			return nil, SiteStatusUnknown, err
+		}
+
+		if site == nil || site.State == nil {
+			return nil, SiteStatusNotFound, nil
+		}
+
+		return site, aws.StringValue(site.State), nil
+	}
+}
+
+// DeviceState fetches the Device and its State
+func DeviceState(conn *networkmanager.NetworkManager, globalNetworkID, deviceID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		device, err := finder.DeviceByID(conn, globalNetworkID, deviceID)
+
+		if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+			return nil, DeviceStatusNotFound, nil
+		}
+
+		if err != nil {
+			return nil, DeviceStatusUnknown, err
+		}
+
+		if device == nil || device.State == nil {
+			return nil, DeviceStatusNotFound, nil
+		}
+
+		return device, aws.StringValue(device.State), nil
+	}
+}
+
+// LinkState fetches the Link and its State
+func LinkState(conn *networkmanager.NetworkManager, globalNetworkID, linkID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		link, err := finder.LinkByID(conn, globalNetworkID, linkID)
+
+		if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+			return nil, LinkStatusNotFound, nil
+		}
+
+		if err != nil {
+			return nil, LinkStatusUnknown, err
+		}
+
+		if link == nil || link.State == nil {
+			return nil, LinkStatusNotFound, nil
+		}
+
+		return link, aws.StringValue(link.State), nil
+	}
+}
+
+// LinkAssociationState fetches the Link Association and its State
+func LinkAssociationState(conn *networkmanager.NetworkManager, globalNetworkID, linkID, deviceID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		linkAssociation, err := finder.LinkAssociation(conn, globalNetworkID, linkID, deviceID)
+
+		if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+			return nil, LinkAssociationStatusNotFound, nil
+		}
+
+		if err != nil {
+			return nil, LinkAssociationStatusUnknown, err
+		}
+
+		if linkAssociation == nil || linkAssociation.LinkAssociationState == nil {
+			return nil, LinkAssociationStatusNotFound, nil
+		}
+
+		return linkAssociation, aws.StringValue(linkAssociation.LinkAssociationState), nil
+	}
+}
+
+// TransitGatewayRegistrationState fetches the Transit Gateway Registration and its State
+func TransitGatewayRegistrationState(conn *networkmanager.NetworkManager, globalNetworkID, transitGatewayARN string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		transitGatewayRegistration, err := finder.TransitGatewayRegistration(conn, globalNetworkID, transitGatewayARN)
+
+		if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+			return nil, TransitGatewayRegistrationStatusNotFound, nil
+		}
+
+		if err != nil {
+			return nil, TransitGatewayRegistrationStatusUnknown, err
+		}
+
+		if transitGatewayRegistration == nil || transitGatewayRegistration.State == nil {
+			return nil, TransitGatewayRegistrationStatusNotFound, nil
+		}
+
+		return transitGatewayRegistration, aws.StringValue(transitGatewayRegistration.State.Code), nil
+	}
+}
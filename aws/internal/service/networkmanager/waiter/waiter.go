@@ -0,0 +1,310 @@
+package waiter
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// GlobalNetworkCreated waits for a Global Network to be created
+func GlobalNetworkCreated(conn *networkmanager.NetworkManager, globalNetworkID string, timeout time.Duration) (*networkmanager.GlobalNetwork, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.GlobalNetworkStatePending},
+		Target:  []string{networkmanager.GlobalNetworkStateAvailable},
+		Refresh: GlobalNetworkState(conn, globalNetworkID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*networkmanager.GlobalNetwork); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// GlobalNetworkUpdated waits for a Global Network to be updated
+func GlobalNetworkUpdated(conn *networkmanager.NetworkManager, globalNetworkID string, timeout time.Duration) (*networkmanager.GlobalNetwork, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.GlobalNetworkStateUpdating},
+		Target:  []string{networkmanager.GlobalNetworkStateAvailable},
+		Refresh: GlobalNetworkState(conn, globalNetworkID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*networkmanager.GlobalNetwork); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// GlobalNetworkDeleted waits for a Global Network to be deleted
+func GlobalNetworkDeleted(conn *networkmanager.NetworkManager, globalNetworkID string, timeout time.Duration) (*networkmanager.GlobalNetwork, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.GlobalNetworkStateDeleting},
+		Target:  []string{},
+		Refresh: GlobalNetworkState(conn, globalNetworkID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*networkmanager.GlobalNetwork); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// SiteCreated waits for a Site to be created
+func SiteCreated(conn *networkmanager.NetworkManager, globalNetworkID, siteID string, timeout time.Duration) (*networkmanager.Site, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.SiteStatePending},
+		Target:  []string{networkmanager.SiteStateAvailable},
+		Refresh: SiteState(conn, globalNetworkID, siteID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*networkmanager.Site); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// SiteUpdated waits for a Site to be updated
+func SiteUpdated(conn *networkmanager.NetworkManager, globalNetworkID, siteID string, timeout time.Duration) (*networkmanager.Site, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.SiteStateUpdating},
+		Target:  []string{networkmanager.SiteStateAvailable},
+		Refresh: SiteState(conn, globalNetworkID, siteID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*networkmanager.Site); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// SiteDeleted waits for a Site to be deleted
+func SiteDeleted(conn *networkmanager.NetworkManager, globalNetworkID, siteID string, timeout time.Duration) (*networkmanager.Site, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.SiteStateDeleting},
+		Target:  []string{},
+		Refresh: SiteState(conn, globalNetworkID, siteID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*networkmanager.Site); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// DeviceCreated waits for a Device to be created
+func DeviceCreated(conn *networkmanager.NetworkManager, globalNetworkID, deviceID string, timeout time.Duration) (*networkmanager.Device, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.DeviceStatePending},
+		Target:  []string{networkmanager.DeviceStateAvailable},
+		Refresh: DeviceState(conn, globalNetworkID, deviceID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*networkmanager.Device); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// DeviceUpdated waits for a Device to be updated
+func DeviceUpdated(conn *networkmanager.NetworkManager, globalNetworkID, deviceID string, timeout time.Duration) (*networkmanager.Device, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.DeviceStateUpdating},
+		Target:  []string{networkmanager.DeviceStateAvailable},
+		Refresh: DeviceState(conn, globalNetworkID, deviceID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*networkmanager.Device); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// DeviceDeleted waits for a Device to be deleted
+func DeviceDeleted(conn *networkmanager.NetworkManager, globalNetworkID, deviceID string, timeout time.Duration) (*networkmanager.Device, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.DeviceStateDeleting},
+		Target:  []string{},
+		Refresh: DeviceState(conn, globalNetworkID, deviceID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*networkmanager.Device); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// LinkCreated waits for a Link to be created
+func LinkCreated(conn *networkmanager.NetworkManager, globalNetworkID, linkID string, timeout time.Duration) (*networkmanager.Link, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.LinkStatePending},
+		Target:  []string{networkmanager.LinkStateAvailable},
+		Refresh: LinkState(conn, globalNetworkID, linkID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*networkmanager.Link); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// LinkUpdated waits for a Link to be updated
+func LinkUpdated(conn *networkmanager.NetworkManager, globalNetworkID, linkID string, timeout time.Duration) (*networkmanager.Link, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.LinkStateUpdating},
+		Target:  []string{networkmanager.LinkStateAvailable},
+		Refresh: LinkState(conn, globalNetworkID, linkID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*networkmanager.Link); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// LinkDeleted waits for a Link to be deleted
+func LinkDeleted(conn *networkmanager.NetworkManager, globalNetworkID, linkID string, timeout time.Duration) (*networkmanager.Link, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.LinkStateDeleting},
+		Target:  []string{},
+		Refresh: LinkState(conn, globalNetworkID, linkID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*networkmanager.Link); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// LinkAssociationCreated waits for a Link Association to be created
+func LinkAssociationCreated(conn *networkmanager.NetworkManager, globalNetworkID, linkID, deviceID string, timeout time.Duration) (*networkmanager.LinkAssociation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.LinkAssociationStatePending},
+		Target:  []string{networkmanager.LinkAssociationStateAvailable},
+		Refresh: LinkAssociationState(conn, globalNetworkID, linkID, deviceID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*networkmanager.LinkAssociation); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// LinkAssociationDeleted waits for a Link Association to be deleted
+func LinkAssociationDeleted(conn *networkmanager.NetworkManager, globalNetworkID, linkID, deviceID string, timeout time.Duration) (*networkmanager.LinkAssociation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.LinkAssociationStateDeleting},
+		Target:  []string{},
+		Refresh: LinkAssociationState(conn, globalNetworkID, linkID, deviceID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*networkmanager.LinkAssociation); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// TransitGatewayRegistrationCreated waits for a Transit Gateway Registration to be created
+func TransitGatewayRegistrationCreated(conn *networkmanager.NetworkManager, globalNetworkID, transitGatewayARN string, timeout time.Duration) (*networkmanager.TransitGatewayRegistration, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.TransitGatewayRegistrationStatePending},
+		Target:  []string{networkmanager.TransitGatewayRegistrationStateAvailable},
+		Refresh: TransitGatewayRegistrationState(conn, globalNetworkID, transitGatewayARN),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*networkmanager.TransitGatewayRegistration); ok {
+		return v, transitGatewayRegistrationStateError(v, err)
+	}
+
+	return nil, err
+}
+
+// TransitGatewayRegistrationDeleted waits for a Transit Gateway Registration to be deleted
+func TransitGatewayRegistrationDeleted(conn *networkmanager.NetworkManager, globalNetworkID, transitGatewayARN string, timeout time.Duration) (*networkmanager.TransitGatewayRegistration, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.TransitGatewayRegistrationStateAvailable, networkmanager.TransitGatewayRegistrationStateDeleting},
+		Target:  []string{},
+		Refresh: TransitGatewayRegistrationState(conn, globalNetworkID, transitGatewayARN),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*networkmanager.TransitGatewayRegistration); ok {
+		return v, transitGatewayRegistrationStateError(v, err)
+	}
+
+	return nil, err
+}
+
+// transitGatewayRegistrationStateError adds the reason for a Transit Gateway Registration in the FAILED state to an error.
+func transitGatewayRegistrationStateError(transitGatewayRegistration *networkmanager.TransitGatewayRegistration, err error) error {
+	var unexpectedStateErr *resource.UnexpectedStateError
+
+	if errors.As(err, &unexpectedStateErr) && transitGatewayRegistration.State != nil && aws.StringValue(transitGatewayRegistration.State.Code) == networkmanager.TransitGatewayRegistrationStateFailed {
+		return fmt.Errorf("%w: %s", err, aws.StringValue(transitGatewayRegistration.State.Message))
+	}
+
+	return err
+}
@@ -0,0 +1,31 @@
+package waiter
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53domains"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// OperationStatus fetches the Operation and its Status
+func OperationStatus(conn *route53domains.Route53Domains, operationID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &route53domains.GetOperationDetailInput{
+			OperationId: aws.String(operationID),
+		}
+
+		output, err := conn.GetOperationDetail(input)
+
+		if err != nil {
+			return nil, route53domains.OperationStatusError, err
+		}
+
+		switch status := aws.StringValue(output.Status); status {
+		case route53domains.OperationStatusError, route53domains.OperationStatusFailed:
+			return output, status, fmt.Errorf("%s", aws.StringValue(output.Message))
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
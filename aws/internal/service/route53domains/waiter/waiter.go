@@ -0,0 +1,26 @@
+package waiter
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/route53domains"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// OperationSucceeded waits for an Operation to return Successful
+func OperationSucceeded(conn *route53domains.Route53Domains, operationID string, timeout time.Duration) (*route53domains.GetOperationDetailOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{route53domains.OperationStatusSubmitted, route53domains.OperationStatusInProgress},
+		Target:  []string{route53domains.OperationStatusSuccessful},
+		Refresh: OperationStatus(conn, operationID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*route53domains.GetOperationDetailOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,26 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	serverlessrepository "github.com/aws/aws-sdk-go/service/serverlessapplicationrepository"
+)
+
+// Application returns the Serverless Application Repository application corresponding to the specified ID.
+// If no semantic version is specified, the latest version is returned.
+func Application(conn *serverlessrepository.ServerlessApplicationRepository, applicationID, semanticVersion string) (*serverlessrepository.GetApplicationOutput, error) {
+	input := &serverlessrepository.GetApplicationInput{
+		ApplicationId: aws.String(applicationID),
+	}
+
+	if semanticVersion != "" {
+		input.SemanticVersion = aws.String(semanticVersion)
+	}
+
+	output, err := conn.GetApplication(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
@@ -0,0 +1,80 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	AccountAssignmentStatusUnknown          = "Unknown"
+	AccountAssignmentStatusNotFound         = "NotFound"
+	PermissionSetProvisioningStatusUnknown  = "Unknown"
+	PermissionSetProvisioningStatusNotFound = "NotFound"
+)
+
+// AccountAssignmentCreationStatus fetches the AccountAssignmentOperationStatus of a creation request and its Status
+func AccountAssignmentCreationStatus(conn *ssoadmin.SSOAdmin, instanceArn, requestID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &ssoadmin.DescribeAccountAssignmentCreationStatusInput{
+			AccountAssignmentCreationRequestId: aws.String(requestID),
+			InstanceArn:                        aws.String(instanceArn),
+		}
+
+		output, err := conn.DescribeAccountAssignmentCreationStatus(input)
+
+		if err != nil {
+			return nil, AccountAssignmentStatusUnknown, err
+		}
+
+		if output == nil || output.AccountAssignmentCreationStatus == nil {
+			return nil, AccountAssignmentStatusNotFound, nil
+		}
+
+		return output.AccountAssignmentCreationStatus, aws.StringValue(output.AccountAssignmentCreationStatus.Status), nil
+	}
+}
+
+// AccountAssignmentDeletionStatus fetches the AccountAssignmentOperationStatus of a deletion request and its Status
+func AccountAssignmentDeletionStatus(conn *ssoadmin.SSOAdmin, instanceArn, requestID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &ssoadmin.DescribeAccountAssignmentDeletionStatusInput{
+			AccountAssignmentDeletionRequestId: aws.String(requestID),
+			InstanceArn:                        aws.String(instanceArn),
+		}
+
+		output, err := conn.DescribeAccountAssignmentDeletionStatus(input)
+
+		if err != nil {
+			return nil, AccountAssignmentStatusUnknown, err
+		}
+
+		if output == nil || output.AccountAssignmentDeletionStatus == nil {
+			return nil, AccountAssignmentStatusNotFound, nil
+		}
+
+		return output.AccountAssignmentDeletionStatus, aws.StringValue(output.AccountAssignmentDeletionStatus.Status), nil
+	}
+}
+
+// PermissionSetProvisioningStatus fetches the PermissionSetProvisioningStatus of a provisioning request and its Status
+func PermissionSetProvisioningStatus(conn *ssoadmin.SSOAdmin, instanceArn, requestID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &ssoadmin.DescribePermissionSetProvisioningStatusInput{
+			InstanceArn:                     aws.String(instanceArn),
+			ProvisionPermissionSetRequestId: aws.String(requestID),
+		}
+
+		output, err := conn.DescribePermissionSetProvisioningStatus(input)
+
+		if err != nil {
+			return nil, PermissionSetProvisioningStatusUnknown, err
+		}
+
+		if output == nil || output.PermissionSetProvisioningStatus == nil {
+			return nil, PermissionSetProvisioningStatusNotFound, nil
+		}
+
+		return output.PermissionSetProvisioningStatus, aws.StringValue(output.PermissionSetProvisioningStatus.Status), nil
+	}
+}
@@ -0,0 +1,92 @@
+package waiter
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	AccountAssignmentCreateTimeout      = 5 * time.Minute
+	AccountAssignmentDeleteTimeout      = 5 * time.Minute
+	AccountAssignmentDelay              = 5 * time.Second
+	AccountAssignmentMinTimeout         = 3 * time.Second
+	PermissionSetProvisioningTimeout    = 10 * time.Minute
+	PermissionSetProvisioningDelay      = 5 * time.Second
+	PermissionSetProvisioningMinTimeout = 3 * time.Second
+)
+
+// AccountAssignmentCreated waits for an account assignment creation request to return SUCCEEDED
+func AccountAssignmentCreated(conn *ssoadmin.SSOAdmin, instanceArn, requestID string) (*ssoadmin.AccountAssignmentOperationStatus, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ssoadmin.StatusValuesInProgress},
+		Target:     []string{ssoadmin.StatusValuesSucceeded},
+		Refresh:    AccountAssignmentCreationStatus(conn, instanceArn, requestID),
+		Timeout:    AccountAssignmentCreateTimeout,
+		Delay:      AccountAssignmentDelay,
+		MinTimeout: AccountAssignmentMinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*ssoadmin.AccountAssignmentOperationStatus); ok {
+		return v, accountAssignmentOperationError(v, err)
+	}
+
+	return nil, err
+}
+
+// AccountAssignmentDeleted waits for an account assignment deletion request to return SUCCEEDED
+func AccountAssignmentDeleted(conn *ssoadmin.SSOAdmin, instanceArn, requestID string) (*ssoadmin.AccountAssignmentOperationStatus, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ssoadmin.StatusValuesInProgress},
+		Target:     []string{ssoadmin.StatusValuesSucceeded},
+		Refresh:    AccountAssignmentDeletionStatus(conn, instanceArn, requestID),
+		Timeout:    AccountAssignmentDeleteTimeout,
+		Delay:      AccountAssignmentDelay,
+		MinTimeout: AccountAssignmentMinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*ssoadmin.AccountAssignmentOperationStatus); ok {
+		return v, accountAssignmentOperationError(v, err)
+	}
+
+	return nil, err
+}
+
+// PermissionSetProvisioned waits for a permission set provisioning request to return SUCCEEDED
+func PermissionSetProvisioned(conn *ssoadmin.SSOAdmin, instanceArn, requestID string) (*ssoadmin.PermissionSetProvisioningStatus, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ssoadmin.StatusValuesInProgress},
+		Target:     []string{ssoadmin.StatusValuesSucceeded},
+		Refresh:    PermissionSetProvisioningStatus(conn, instanceArn, requestID),
+		Timeout:    PermissionSetProvisioningTimeout,
+		Delay:      PermissionSetProvisioningDelay,
+		MinTimeout: PermissionSetProvisioningMinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*ssoadmin.PermissionSetProvisioningStatus); ok {
+		if err != nil && v.FailureReason != nil {
+			return v, fmt.Errorf("%w: %s", err, aws.StringValue(v.FailureReason))
+		}
+
+		return v, err
+	}
+
+	return nil, err
+}
+
+func accountAssignmentOperationError(status *ssoadmin.AccountAssignmentOperationStatus, err error) error {
+	if err != nil && status.FailureReason != nil {
+		return fmt.Errorf("%w: %s", err, aws.StringValue(status.FailureReason))
+	}
+
+	return err
+}
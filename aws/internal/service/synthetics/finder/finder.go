@@ -0,0 +1,25 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/synthetics"
+)
+
+// CanaryByName returns the Canary corresponding to the specified Name.
+// Returns nil if no Canary is found.
+func CanaryByName(conn *synthetics.Synthetics, name string) (*synthetics.Canary, error) {
+	input := &synthetics.GetCanaryInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetCanary(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.Canary, nil
+}
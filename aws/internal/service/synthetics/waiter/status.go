@@ -0,0 +1,35 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/synthetics"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/synthetics/finder"
+)
+
+const (
+	CanaryStatusNotFound = "NotFound"
+	CanaryStatusUnknown  = "Unknown"
+)
+
+// CanaryStatus fetches the Canary and its Status
+func CanaryStatus(conn *synthetics.Synthetics, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		canary, err := finder.CanaryByName(conn, name)
+
+		if tfawserr.ErrCodeEquals(err, synthetics.ErrCodeResourceNotFoundException) {
+			return nil, CanaryStatusNotFound, nil
+		}
+
+		if err != nil {
+			return nil, CanaryStatusUnknown, err
+		}
+
+		if canary == nil || canary.Status == nil {
+			return nil, CanaryStatusNotFound, nil
+		}
+
+		return canary, aws.StringValue(canary.Status.State), nil
+	}
+}
@@ -0,0 +1,102 @@
+package waiter
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/synthetics"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// Maximum amount of time to wait for a Canary to return Ready
+	CanaryCreatedTimeout = 5 * time.Minute
+	// Maximum amount of time to wait for a Canary to return Running
+	CanaryRunningTimeout = 5 * time.Minute
+	// Maximum amount of time to wait for a Canary to return Stopped
+	CanaryStoppedTimeout = 5 * time.Minute
+	// Maximum amount of time to wait for a Canary to be deleted
+	CanaryDeletedTimeout = 5 * time.Minute
+)
+
+// CanaryReady waits for a Canary to return Ready
+func CanaryReady(conn *synthetics.Synthetics, name string) (*synthetics.Canary, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{synthetics.CanaryStateCreating, synthetics.CanaryStateUpdating},
+		Target:  []string{synthetics.CanaryStateReady},
+		Refresh: CanaryStatus(conn, name),
+		Timeout: CanaryCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*synthetics.Canary); ok {
+		return v, canaryStateError(v, err)
+	}
+
+	return nil, err
+}
+
+// CanaryRunning waits for a Canary to return Running
+func CanaryRunning(conn *synthetics.Synthetics, name string) (*synthetics.Canary, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{synthetics.CanaryStateStarting, synthetics.CanaryStateUpdating},
+		Target:  []string{synthetics.CanaryStateRunning},
+		Refresh: CanaryStatus(conn, name),
+		Timeout: CanaryRunningTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*synthetics.Canary); ok {
+		return v, canaryStateError(v, err)
+	}
+
+	return nil, err
+}
+
+// CanaryStopped waits for a Canary to return Stopped
+func CanaryStopped(conn *synthetics.Synthetics, name string) (*synthetics.Canary, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{synthetics.CanaryStateStopping, synthetics.CanaryStateUpdating, synthetics.CanaryStateRunning},
+		Target:  []string{synthetics.CanaryStateStopped},
+		Refresh: CanaryStatus(conn, name),
+		Timeout: CanaryStoppedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*synthetics.Canary); ok {
+		return v, canaryStateError(v, err)
+	}
+
+	return nil, err
+}
+
+// CanaryDeleted waits for a Canary to be deleted
+func CanaryDeleted(conn *synthetics.Synthetics, name string) (*synthetics.Canary, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{synthetics.CanaryStateDeleting, synthetics.CanaryStateStopped},
+		Target:  []string{},
+		Refresh: CanaryStatus(conn, name),
+		Timeout: CanaryDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*synthetics.Canary); ok {
+		return v, canaryStateError(v, err)
+	}
+
+	return nil, err
+}
+
+// canaryStateError adds the reason for a Canary in the ERROR state to an error.
+func canaryStateError(canary *synthetics.Canary, err error) error {
+	if err != nil && canary.Status != nil && aws.StringValue(canary.Status.State) == synthetics.CanaryStateError {
+		return fmt.Errorf("%w: %s", err, aws.StringValue(canary.Status.StateReason))
+	}
+
+	return err
+}
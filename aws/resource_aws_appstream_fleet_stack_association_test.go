@@ -0,0 +1,149 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfappstream "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream/finder"
+)
+
+func TestAccAWSAppStreamFleetStackAssociation_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_appstream_fleet_stack_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(appstream.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppStreamFleetStackAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppStreamFleetStackAssociationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamFleetStackAssociationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "fleet_name", "aws_appstream_fleet.test", "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "stack_name", "aws_appstream_stack.test", "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAppStreamFleetStackAssociation_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_appstream_fleet_stack_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(appstream.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppStreamFleetStackAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppStreamFleetStackAssociationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamFleetStackAssociationExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsAppStreamFleetStackAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAppStreamFleetStackAssociationExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No AppStream Fleet Stack Association ID is set")
+		}
+
+		fleetName, stackName, err := tfappstream.FleetStackAssociationParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).appstreamconn
+
+		association, err := finder.FleetStackAssociationByFleetNameAndStackName(conn, fleetName, stackName)
+
+		if err != nil {
+			return err
+		}
+
+		if association == nil {
+			return fmt.Errorf("AppStream Fleet Stack Association (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSAppStreamFleetStackAssociationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).appstreamconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_appstream_fleet_stack_association" {
+			continue
+		}
+
+		fleetName, stackName, err := tfappstream.FleetStackAssociationParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		association, err := finder.FleetStackAssociationByFleetNameAndStackName(conn, fleetName, stackName)
+
+		if tfawserr.ErrCodeEquals(err, appstream.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if association != nil {
+			return fmt.Errorf("AppStream Fleet Stack Association (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSAppStreamFleetStackAssociationConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_appstream_fleet" "test" {
+  name          = %[1]q
+  image_name    = "Amazon-AppStream2-Sample-Image-02-04-2019"
+  instance_type = "stream.standard.small"
+
+  compute_capacity {
+    desired_instances = 1
+  }
+}
+
+resource "aws_appstream_stack" "test" {
+  name = %[1]q
+}
+
+resource "aws_appstream_fleet_stack_association" "test" {
+  fleet_name = aws_appstream_fleet.test.name
+  stack_name = aws_appstream_stack.test.name
+}
+`, rName)
+}
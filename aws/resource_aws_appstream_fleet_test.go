@@ -0,0 +1,383 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream/finder"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_appstream_fleet", &resource.Sweeper{
+		Name: "aws_appstream_fleet",
+		F:    testSweepAppStreamFleets,
+	})
+}
+
+func testSweepAppStreamFleets(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.(*AWSClient).appstreamconn
+	input := &appstream.DescribeFleetsInput{}
+	var sweeperErrs *multierror.Error
+
+	for {
+		output, err := conn.DescribeFleets(input)
+
+		if testSweepSkipSweepError(err) {
+			log.Printf("[WARN] Skipping AppStream Fleet sweep for %s: %s", region, err)
+			return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		}
+
+		if err != nil {
+			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving AppStream Fleets: %w", err))
+			return sweeperErrs.ErrorOrNil()
+		}
+
+		for _, fleet := range output.Fleets {
+			name := aws.StringValue(fleet.Name)
+			log.Printf("[INFO] Deleting AppStream Fleet: %s", name)
+
+			r := resourceAwsAppStreamFleet()
+			d := r.Data(nil)
+			d.SetId(name)
+
+			if err := r.Delete(d, client); err != nil {
+				log.Printf("[ERROR] %s", err)
+				sweeperErrs = multierror.Append(sweeperErrs, err)
+				continue
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSAppStreamFleet_basic(t *testing.T) {
+	var fleet appstream.Fleet
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_appstream_fleet.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(appstream.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppStreamFleetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppStreamFleetConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamFleetExists(resourceName, &fleet),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "appstream", regexp.MustCompile(`fleet/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "compute_capacity.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "compute_capacity.0.desired_instances", "1"),
+					testAccCheckResourceAttrRfc3339(resourceName, "created_time"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "display_name", ""),
+					resource.TestCheckResourceAttr(resourceName, "domain_join_info.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "fleet_type", appstream.FleetTypeOnDemand),
+					resource.TestCheckResourceAttr(resourceName, "iam_role_arn", ""),
+					resource.TestCheckResourceAttr(resourceName, "image_name", "Amazon-AppStream2-Sample-Image-02-04-2019"),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", "stream.standard.small"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "state", appstream.FleetStateRunning),
+					resource.TestCheckResourceAttr(resourceName, "stream_view", appstream.StreamViewApp),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAppStreamFleet_disappears(t *testing.T) {
+	var fleet appstream.Fleet
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_appstream_fleet.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(appstream.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppStreamFleetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppStreamFleetConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamFleetExists(resourceName, &fleet),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsAppStreamFleet(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAppStreamFleet_Tags(t *testing.T) {
+	var fleet appstream.Fleet
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_appstream_fleet.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(appstream.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppStreamFleetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppStreamFleetConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamFleetExists(resourceName, &fleet),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSAppStreamFleetConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamFleetExists(resourceName, &fleet),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSAppStreamFleetConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamFleetExists(resourceName, &fleet),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSAppStreamFleet_update(t *testing.T) {
+	var fleet appstream.Fleet
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_appstream_fleet.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(appstream.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppStreamFleetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppStreamFleetConfigComplete(rName, "description1", "stream.standard.small", 1, 900),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamFleetExists(resourceName, &fleet),
+					resource.TestCheckResourceAttr(resourceName, "compute_capacity.0.desired_instances", "1"),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttr(resourceName, "disconnect_timeout_in_seconds", "900"),
+					resource.TestCheckResourceAttr(resourceName, "display_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "enable_default_internet_access", "false"),
+					resource.TestCheckResourceAttr(resourceName, "fleet_type", appstream.FleetTypeAlwaysOn),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", "stream.standard.small"),
+					resource.TestCheckResourceAttr(resourceName, "max_user_duration_in_seconds", "600"),
+					resource.TestCheckResourceAttr(resourceName, "state", appstream.FleetStateRunning),
+					resource.TestCheckResourceAttr(resourceName, "vpc_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_config.0.subnet_ids.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_config.0.subnet_ids.0", "aws_subnet.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Changes to capacity and timeouts are applied to the running Fleet.
+				Config: testAccAWSAppStreamFleetConfigComplete(rName, "description1", "stream.standard.small", 2, 1200),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamFleetExists(resourceName, &fleet),
+					resource.TestCheckResourceAttr(resourceName, "compute_capacity.0.desired_instances", "2"),
+					resource.TestCheckResourceAttr(resourceName, "disconnect_timeout_in_seconds", "1200"),
+					resource.TestCheckResourceAttr(resourceName, "state", appstream.FleetStateRunning),
+				),
+			},
+			{
+				// Changes to the description and instance type require the Fleet to be stopped.
+				Config: testAccAWSAppStreamFleetConfigComplete(rName, "description2", "stream.standard.medium", 2, 1200),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamFleetExists(resourceName, &fleet),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", "stream.standard.medium"),
+					resource.TestCheckResourceAttr(resourceName, "state", appstream.FleetStateRunning),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAppStreamFleetExists(resourceName string, v *appstream.Fleet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No AppStream Fleet ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).appstreamconn
+
+		fleet, err := finder.FleetByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if fleet == nil {
+			return fmt.Errorf("AppStream Fleet (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *fleet
+
+		return nil
+	}
+}
+
+func testAccCheckAWSAppStreamFleetDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).appstreamconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_appstream_fleet" {
+			continue
+		}
+
+		fleet, err := finder.FleetByName(conn, rs.Primary.ID)
+
+		if tfawserr.ErrCodeEquals(err, appstream.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if fleet != nil {
+			return fmt.Errorf("AppStream Fleet (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSAppStreamFleetConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_appstream_fleet" "test" {
+  name          = %[1]q
+  image_name    = "Amazon-AppStream2-Sample-Image-02-04-2019"
+  instance_type = "stream.standard.small"
+
+  compute_capacity {
+    desired_instances = 1
+  }
+}
+`, rName)
+}
+
+func testAccAWSAppStreamFleetConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_appstream_fleet" "test" {
+  name          = %[1]q
+  image_name    = "Amazon-AppStream2-Sample-Image-02-04-2019"
+  instance_type = "stream.standard.small"
+
+  compute_capacity {
+    desired_instances = 1
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAWSAppStreamFleetConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_appstream_fleet" "test" {
+  name          = %[1]q
+  image_name    = "Amazon-AppStream2-Sample-Image-02-04-2019"
+  instance_type = "stream.standard.small"
+
+  compute_capacity {
+    desired_instances = 1
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
+
+func testAccAWSAppStreamFleetConfigComplete(rName, description, instanceType string, desiredInstances, disconnectTimeout int) string {
+	return composeConfig(testAccAvailableAZsNoOptInConfig(), fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  cidr_block        = "10.1.1.0/24"
+  vpc_id            = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_appstream_fleet" "test" {
+  name                               = %[1]q
+  description                        = %[2]q
+  display_name                       = %[1]q
+  disconnect_timeout_in_seconds      = %[5]d
+  enable_default_internet_access     = false
+  fleet_type                         = "ALWAYS_ON"
+  idle_disconnect_timeout_in_seconds = 60
+  image_name                         = "Amazon-AppStream2-Sample-Image-02-04-2019"
+  instance_type                      = %[3]q
+  max_user_duration_in_seconds       = 600
+
+  compute_capacity {
+    desired_instances = %[4]d
+  }
+
+  vpc_config {
+    subnet_ids = [aws_subnet.test.id]
+  }
+}
+`, rName, description, instanceType, desiredInstances, disconnectTimeout))
+}
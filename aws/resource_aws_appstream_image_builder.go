@@ -0,0 +1,410 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream/waiter"
+	iamwaiter "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iam/waiter"
+)
+
+func resourceAwsAppStreamImageBuilder() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAppStreamImageBuilderCreate,
+		Read:   resourceAwsAppStreamImageBuilderRead,
+		Update: resourceAwsAppStreamImageBuilderUpdate,
+		Delete: resourceAwsAppStreamImageBuilderDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"access_endpoints": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 4,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"endpoint_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(appstream.AccessEndpointType_Values(), false),
+						},
+
+						"vpce_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+
+			"appstream_agent_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+
+			"display_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 100),
+			},
+
+			"domain_join_info": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"directory_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+
+						"organizational_unit_distinguished_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(0, 2000),
+						},
+					},
+				},
+			},
+
+			"enable_default_internet_access": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"iam_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+
+			"image_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+				ExactlyOneOf: []string{"image_arn", "image_name"},
+			},
+
+			"image_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+				ExactlyOneOf: []string{"image_arn", "image_name"},
+			},
+
+			"instance_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 100),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`), "must start with an alphanumeric character and contain only alphanumeric characters, underscores, periods and hyphens"),
+				),
+			},
+
+			"platform": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+
+			"vpc_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"security_group_ids": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+
+						"subnet_ids": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsAppStreamImageBuilderCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appstreamconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+
+	input := &appstream.CreateImageBuilderInput{
+		InstanceType: aws.String(d.Get("instance_type").(string)),
+		Name:         aws.String(name),
+	}
+
+	if v, ok := d.GetOk("access_endpoints"); ok && v.(*schema.Set).Len() > 0 {
+		input.AccessEndpoints = expandAppStreamAccessEndpoints(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("appstream_agent_version"); ok {
+		input.AppstreamAgentVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("display_name"); ok {
+		input.DisplayName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("domain_join_info"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.DomainJoinInfo = expandAppStreamDomainJoinInfo(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("enable_default_internet_access"); ok {
+		input.EnableDefaultInternetAccess = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("iam_role_arn"); ok {
+		input.IamRoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("image_arn"); ok {
+		input.ImageArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("image_name"); ok {
+		input.ImageName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("vpc_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.VpcConfig = expandAppStreamVpcConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().AppstreamTags()
+	}
+
+	log.Printf("[DEBUG] Creating AppStream Image Builder: %s", input)
+	err := resource.Retry(iamwaiter.PropagationTimeout, func() *resource.RetryError {
+		_, err := conn.CreateImageBuilder(input)
+
+		// Newly created IAM roles may not yet be assumable by AppStream.
+		if tfawserr.ErrCodeEquals(err, appstream.ErrCodeInvalidRoleException) {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if isResourceTimeoutError(err) {
+		_, err = conn.CreateImageBuilder(input)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error creating AppStream Image Builder (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waiter.ImageBuilderRunning(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for AppStream Image Builder (%s) to start: %w", d.Id(), err)
+	}
+
+	return resourceAwsAppStreamImageBuilderRead(d, meta)
+}
+
+func resourceAwsAppStreamImageBuilderRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appstreamconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	imageBuilder, err := finder.ImageBuilderByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, appstream.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] AppStream Image Builder (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading AppStream Image Builder (%s): %w", d.Id(), err)
+	}
+
+	if imageBuilder == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading AppStream Image Builder (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] AppStream Image Builder (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("access_endpoints", flattenAppStreamAccessEndpoints(imageBuilder.AccessEndpoints)); err != nil {
+		return fmt.Errorf("error setting access_endpoints: %w", err)
+	}
+
+	d.Set("appstream_agent_version", imageBuilder.AppstreamAgentVersion)
+	arn := aws.StringValue(imageBuilder.Arn)
+	d.Set("arn", arn)
+	d.Set("created_time", aws.TimeValue(imageBuilder.CreatedTime).Format(time.RFC3339))
+	d.Set("description", imageBuilder.Description)
+	d.Set("display_name", imageBuilder.DisplayName)
+
+	if imageBuilder.DomainJoinInfo != nil {
+		if err := d.Set("domain_join_info", []interface{}{flattenAppStreamDomainJoinInfo(imageBuilder.DomainJoinInfo)}); err != nil {
+			return fmt.Errorf("error setting domain_join_info: %w", err)
+		}
+	} else {
+		d.Set("domain_join_info", nil)
+	}
+
+	d.Set("enable_default_internet_access", imageBuilder.EnableDefaultInternetAccess)
+	d.Set("iam_role_arn", imageBuilder.IamRoleArn)
+	d.Set("image_arn", imageBuilder.ImageArn)
+	d.Set("instance_type", imageBuilder.InstanceType)
+	d.Set("name", imageBuilder.Name)
+	d.Set("platform", imageBuilder.Platform)
+	d.Set("state", imageBuilder.State)
+
+	if imageBuilder.VpcConfig != nil {
+		if err := d.Set("vpc_config", []interface{}{flattenAppStreamVpcConfig(imageBuilder.VpcConfig)}); err != nil {
+			return fmt.Errorf("error setting vpc_config: %w", err)
+		}
+	} else {
+		d.Set("vpc_config", nil)
+	}
+
+	tags, err := keyvaluetags.AppstreamListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for AppStream Image Builder (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsAppStreamImageBuilderUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appstreamconn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.AppstreamUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating AppStream Image Builder (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsAppStreamImageBuilderRead(d, meta)
+}
+
+func resourceAwsAppStreamImageBuilderDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appstreamconn
+
+	log.Printf("[DEBUG] Deleting AppStream Image Builder (%s)", d.Id())
+	_, err := conn.DeleteImageBuilder(&appstream.DeleteImageBuilderInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, appstream.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting AppStream Image Builder (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.ImageBuilderDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for AppStream Image Builder (%s) to delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,269 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream/finder"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_appstream_image_builder", &resource.Sweeper{
+		Name: "aws_appstream_image_builder",
+		F:    testSweepAppStreamImageBuilders,
+	})
+}
+
+func testSweepAppStreamImageBuilders(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.(*AWSClient).appstreamconn
+	input := &appstream.DescribeImageBuildersInput{}
+	var sweeperErrs *multierror.Error
+
+	for {
+		output, err := conn.DescribeImageBuilders(input)
+
+		if testSweepSkipSweepError(err) {
+			log.Printf("[WARN] Skipping AppStream Image Builder sweep for %s: %s", region, err)
+			return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		}
+
+		if err != nil {
+			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving AppStream Image Builders: %w", err))
+			return sweeperErrs.ErrorOrNil()
+		}
+
+		for _, imageBuilder := range output.ImageBuilders {
+			name := aws.StringValue(imageBuilder.Name)
+			log.Printf("[INFO] Deleting AppStream Image Builder: %s", name)
+
+			r := resourceAwsAppStreamImageBuilder()
+			d := r.Data(nil)
+			d.SetId(name)
+
+			if err := r.Delete(d, client); err != nil {
+				log.Printf("[ERROR] %s", err)
+				sweeperErrs = multierror.Append(sweeperErrs, err)
+				continue
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSAppStreamImageBuilder_basic(t *testing.T) {
+	var imageBuilder appstream.ImageBuilder
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_appstream_image_builder.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(appstream.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppStreamImageBuilderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppStreamImageBuilderConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamImageBuilderExists(resourceName, &imageBuilder),
+					resource.TestCheckResourceAttr(resourceName, "access_endpoints.#", "0"),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "appstream", regexp.MustCompile(`image-builder/.+`)),
+					testAccCheckResourceAttrRfc3339(resourceName, "created_time"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "domain_join_info.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "iam_role_arn", ""),
+					resource.TestCheckResourceAttr(resourceName, "image_name", "Amazon-AppStream2-Sample-Image-02-04-2019"),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", "stream.standard.small"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "state", appstream.ImageBuilderStateRunning),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"image_name"},
+			},
+		},
+	})
+}
+
+func TestAccAWSAppStreamImageBuilder_disappears(t *testing.T) {
+	var imageBuilder appstream.ImageBuilder
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_appstream_image_builder.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(appstream.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppStreamImageBuilderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppStreamImageBuilderConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamImageBuilderExists(resourceName, &imageBuilder),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsAppStreamImageBuilder(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAppStreamImageBuilder_Tags(t *testing.T) {
+	var imageBuilder appstream.ImageBuilder
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_appstream_image_builder.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(appstream.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppStreamImageBuilderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppStreamImageBuilderConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamImageBuilderExists(resourceName, &imageBuilder),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"image_name"},
+			},
+			{
+				Config: testAccAWSAppStreamImageBuilderConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamImageBuilderExists(resourceName, &imageBuilder),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSAppStreamImageBuilderConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamImageBuilderExists(resourceName, &imageBuilder),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAppStreamImageBuilderExists(resourceName string, v *appstream.ImageBuilder) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No AppStream Image Builder ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).appstreamconn
+
+		imageBuilder, err := finder.ImageBuilderByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if imageBuilder == nil {
+			return fmt.Errorf("AppStream Image Builder (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *imageBuilder
+
+		return nil
+	}
+}
+
+func testAccCheckAWSAppStreamImageBuilderDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).appstreamconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_appstream_image_builder" {
+			continue
+		}
+
+		imageBuilder, err := finder.ImageBuilderByName(conn, rs.Primary.ID)
+
+		if tfawserr.ErrCodeEquals(err, appstream.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if imageBuilder != nil {
+			return fmt.Errorf("AppStream Image Builder (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSAppStreamImageBuilderConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_appstream_image_builder" "test" {
+  name          = %[1]q
+  image_name    = "Amazon-AppStream2-Sample-Image-02-04-2019"
+  instance_type = "stream.standard.small"
+}
+`, rName)
+}
+
+func testAccAWSAppStreamImageBuilderConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_appstream_image_builder" "test" {
+  name          = %[1]q
+  image_name    = "Amazon-AppStream2-Sample-Image-02-04-2019"
+  instance_type = "stream.standard.small"
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAWSAppStreamImageBuilderConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_appstream_image_builder" "test" {
+  name          = %[1]q
+  image_name    = "Amazon-AppStream2-Sample-Image-02-04-2019"
+  instance_type = "stream.standard.small"
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
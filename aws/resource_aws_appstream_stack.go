@@ -0,0 +1,731 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream/finder"
+)
+
+func resourceAwsAppStreamStack() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAppStreamStackCreate,
+		Read:   resourceAwsAppStreamStackRead,
+		Update: resourceAwsAppStreamStackUpdate,
+		Delete: resourceAwsAppStreamStackDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"access_endpoints": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MinItems: 1,
+				MaxItems: 4,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"endpoint_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(appstream.AccessEndpointType_Values(), false),
+						},
+
+						"vpce_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"application_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+
+						"settings_group": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 100),
+						},
+					},
+				},
+			},
+
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+
+			"display_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 100),
+			},
+
+			"embed_host_domains": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MinItems: 1,
+				MaxItems: 20,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(0, 128),
+				},
+			},
+
+			"feedback_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 100),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`), "must start with an alphanumeric character and contain only alphanumeric characters, underscores, periods and hyphens"),
+				),
+			},
+
+			"redirect_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+
+			"storage_connectors": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"connector_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(appstream.StorageConnectorType_Values(), false),
+						},
+
+						"domains": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 50,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(1, 64),
+							},
+						},
+
+						"resource_identifier": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 2048),
+						},
+					},
+				},
+			},
+
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+
+			"user_settings": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(appstream.Action_Values(), false),
+						},
+
+						"permission": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(appstream.Permission_Values(), false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsAppStreamStackCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appstreamconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+
+	input := &appstream.CreateStackInput{
+		Name: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("access_endpoints"); ok && v.(*schema.Set).Len() > 0 {
+		input.AccessEndpoints = expandAppStreamAccessEndpoints(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("application_settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ApplicationSettings = expandAppStreamApplicationSettings(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("display_name"); ok {
+		input.DisplayName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("embed_host_domains"); ok && v.(*schema.Set).Len() > 0 {
+		input.EmbedHostDomains = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("feedback_url"); ok {
+		input.FeedbackURL = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("redirect_url"); ok {
+		input.RedirectURL = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("storage_connectors"); ok && v.(*schema.Set).Len() > 0 {
+		input.StorageConnectors = expandAppStreamStorageConnectors(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("user_settings"); ok && v.(*schema.Set).Len() > 0 {
+		input.UserSettings = expandAppStreamUserSettings(v.(*schema.Set).List())
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().AppstreamTags()
+	}
+
+	log.Printf("[DEBUG] Creating AppStream Stack: %s", input)
+	_, err := conn.CreateStack(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating AppStream Stack (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsAppStreamStackRead(d, meta)
+}
+
+func resourceAwsAppStreamStackRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appstreamconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	stack, err := finder.StackByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, appstream.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] AppStream Stack (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading AppStream Stack (%s): %w", d.Id(), err)
+	}
+
+	if stack == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading AppStream Stack (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] AppStream Stack (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("access_endpoints", flattenAppStreamAccessEndpoints(stack.AccessEndpoints)); err != nil {
+		return fmt.Errorf("error setting access_endpoints: %w", err)
+	}
+
+	if stack.ApplicationSettings != nil {
+		if err := d.Set("application_settings", []interface{}{flattenAppStreamApplicationSettingsResponse(stack.ApplicationSettings)}); err != nil {
+			return fmt.Errorf("error setting application_settings: %w", err)
+		}
+	} else {
+		d.Set("application_settings", nil)
+	}
+
+	arn := aws.StringValue(stack.Arn)
+	d.Set("arn", arn)
+	d.Set("created_time", aws.TimeValue(stack.CreatedTime).Format(time.RFC3339))
+	d.Set("description", stack.Description)
+	d.Set("display_name", stack.DisplayName)
+
+	if err := d.Set("embed_host_domains", aws.StringValueSlice(stack.EmbedHostDomains)); err != nil {
+		return fmt.Errorf("error setting embed_host_domains: %w", err)
+	}
+
+	d.Set("feedback_url", stack.FeedbackURL)
+	d.Set("name", stack.Name)
+	d.Set("redirect_url", stack.RedirectURL)
+
+	if err := d.Set("storage_connectors", flattenAppStreamStorageConnectors(stack.StorageConnectors)); err != nil {
+		return fmt.Errorf("error setting storage_connectors: %w", err)
+	}
+
+	if err := d.Set("user_settings", flattenAppStreamUserSettings(stack.UserSettings)); err != nil {
+		return fmt.Errorf("error setting user_settings: %w", err)
+	}
+
+	tags, err := keyvaluetags.AppstreamListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for AppStream Stack (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsAppStreamStackUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appstreamconn
+
+	if d.HasChanges(
+		"access_endpoints",
+		"application_settings",
+		"description",
+		"display_name",
+		"embed_host_domains",
+		"feedback_url",
+		"redirect_url",
+		"storage_connectors",
+		"user_settings",
+	) {
+		input := &appstream.UpdateStackInput{
+			Name: aws.String(d.Id()),
+		}
+
+		if d.HasChange("access_endpoints") {
+			if v := d.Get("access_endpoints").(*schema.Set); v.Len() > 0 {
+				input.AccessEndpoints = expandAppStreamAccessEndpoints(v.List())
+			} else {
+				input.AttributesToDelete = append(input.AttributesToDelete, aws.String(appstream.StackAttributeAccessEndpoints))
+			}
+		}
+
+		if d.HasChange("application_settings") {
+			if v, ok := d.GetOk("application_settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.ApplicationSettings = expandAppStreamApplicationSettings(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("display_name") {
+			input.DisplayName = aws.String(d.Get("display_name").(string))
+		}
+
+		if d.HasChange("embed_host_domains") {
+			if v := d.Get("embed_host_domains").(*schema.Set); v.Len() > 0 {
+				input.EmbedHostDomains = expandStringSet(v)
+			} else {
+				input.AttributesToDelete = append(input.AttributesToDelete, aws.String(appstream.StackAttributeEmbedHostDomains))
+			}
+		}
+
+		if d.HasChange("feedback_url") {
+			if v, ok := d.GetOk("feedback_url"); ok {
+				input.FeedbackURL = aws.String(v.(string))
+			} else {
+				input.AttributesToDelete = append(input.AttributesToDelete, aws.String(appstream.StackAttributeFeedbackUrl))
+			}
+		}
+
+		if d.HasChange("redirect_url") {
+			if v, ok := d.GetOk("redirect_url"); ok {
+				input.RedirectURL = aws.String(v.(string))
+			} else {
+				input.AttributesToDelete = append(input.AttributesToDelete, aws.String(appstream.StackAttributeRedirectUrl))
+			}
+		}
+
+		if d.HasChange("storage_connectors") {
+			if v := d.Get("storage_connectors").(*schema.Set); v.Len() > 0 {
+				input.StorageConnectors = expandAppStreamStorageConnectors(v.List())
+			} else {
+				input.AttributesToDelete = append(input.AttributesToDelete, aws.String(appstream.StackAttributeStorageConnectors))
+			}
+		}
+
+		if d.HasChange("user_settings") {
+			if v := d.Get("user_settings").(*schema.Set); v.Len() > 0 {
+				input.UserSettings = expandAppStreamUserSettings(v.List())
+			} else {
+				input.AttributesToDelete = append(input.AttributesToDelete, aws.String(appstream.StackAttributeUserSettings))
+			}
+		}
+
+		log.Printf("[DEBUG] Updating AppStream Stack: %s", input)
+		_, err := conn.UpdateStack(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating AppStream Stack (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.AppstreamUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating AppStream Stack (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsAppStreamStackRead(d, meta)
+}
+
+func resourceAwsAppStreamStackDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appstreamconn
+
+	log.Printf("[DEBUG] Deleting AppStream Stack (%s)", d.Id())
+	_, err := conn.DeleteStack(&appstream.DeleteStackInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, appstream.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting AppStream Stack (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandAppStreamAccessEndpoint(tfMap map[string]interface{}) *appstream.AccessEndpoint {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &appstream.AccessEndpoint{}
+
+	if v, ok := tfMap["endpoint_type"].(string); ok && v != "" {
+		apiObject.EndpointType = aws.String(v)
+	}
+
+	if v, ok := tfMap["vpce_id"].(string); ok && v != "" {
+		apiObject.VpceId = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandAppStreamAccessEndpoints(tfList []interface{}) []*appstream.AccessEndpoint {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*appstream.AccessEndpoint
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandAppStreamAccessEndpoint(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenAppStreamAccessEndpoint(apiObject *appstream.AccessEndpoint) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.EndpointType; v != nil {
+		tfMap["endpoint_type"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.VpceId; v != nil {
+		tfMap["vpce_id"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenAppStreamAccessEndpoints(apiObjects []*appstream.AccessEndpoint) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenAppStreamAccessEndpoint(apiObject))
+	}
+
+	return tfList
+}
+
+func expandAppStreamApplicationSettings(tfMap map[string]interface{}) *appstream.ApplicationSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &appstream.ApplicationSettings{}
+
+	if v, ok := tfMap["enabled"].(bool); ok {
+		apiObject.Enabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["settings_group"].(string); ok && v != "" {
+		apiObject.SettingsGroup = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenAppStreamApplicationSettingsResponse(apiObject *appstream.ApplicationSettingsResponse) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Enabled; v != nil {
+		tfMap["enabled"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.SettingsGroup; v != nil {
+		tfMap["settings_group"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func expandAppStreamStorageConnector(tfMap map[string]interface{}) *appstream.StorageConnector {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &appstream.StorageConnector{}
+
+	if v, ok := tfMap["connector_type"].(string); ok && v != "" {
+		apiObject.ConnectorType = aws.String(v)
+	}
+
+	if v, ok := tfMap["domains"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Domains = expandStringList(v)
+	}
+
+	if v, ok := tfMap["resource_identifier"].(string); ok && v != "" {
+		apiObject.ResourceIdentifier = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandAppStreamStorageConnectors(tfList []interface{}) []*appstream.StorageConnector {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*appstream.StorageConnector
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandAppStreamStorageConnector(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenAppStreamStorageConnector(apiObject *appstream.StorageConnector) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ConnectorType; v != nil {
+		tfMap["connector_type"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Domains; v != nil {
+		tfMap["domains"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.ResourceIdentifier; v != nil {
+		tfMap["resource_identifier"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenAppStreamStorageConnectors(apiObjects []*appstream.StorageConnector) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenAppStreamStorageConnector(apiObject))
+	}
+
+	return tfList
+}
+
+func expandAppStreamUserSetting(tfMap map[string]interface{}) *appstream.UserSetting {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &appstream.UserSetting{}
+
+	if v, ok := tfMap["action"].(string); ok && v != "" {
+		apiObject.Action = aws.String(v)
+	}
+
+	if v, ok := tfMap["permission"].(string); ok && v != "" {
+		apiObject.Permission = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandAppStreamUserSettings(tfList []interface{}) []*appstream.UserSetting {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*appstream.UserSetting
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandAppStreamUserSetting(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenAppStreamUserSetting(apiObject *appstream.UserSetting) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Action; v != nil {
+		tfMap["action"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Permission; v != nil {
+		tfMap["permission"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenAppStreamUserSettings(apiObjects []*appstream.UserSetting) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenAppStreamUserSetting(apiObject))
+	}
+
+	return tfList
+}
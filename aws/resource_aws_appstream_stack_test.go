@@ -0,0 +1,371 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfawsresource"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_appstream_stack", &resource.Sweeper{
+		Name: "aws_appstream_stack",
+		F:    testSweepAppStreamStacks,
+		Dependencies: []string{
+			"aws_appstream_fleet",
+		},
+	})
+}
+
+func testSweepAppStreamStacks(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.(*AWSClient).appstreamconn
+	input := &appstream.DescribeStacksInput{}
+	var sweeperErrs *multierror.Error
+
+	for {
+		output, err := conn.DescribeStacks(input)
+
+		if testSweepSkipSweepError(err) {
+			log.Printf("[WARN] Skipping AppStream Stack sweep for %s: %s", region, err)
+			return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		}
+
+		if err != nil {
+			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving AppStream Stacks: %w", err))
+			return sweeperErrs.ErrorOrNil()
+		}
+
+		for _, stack := range output.Stacks {
+			name := aws.StringValue(stack.Name)
+			log.Printf("[INFO] Deleting AppStream Stack: %s", name)
+
+			r := resourceAwsAppStreamStack()
+			d := r.Data(nil)
+			d.SetId(name)
+
+			if err := r.Delete(d, client); err != nil {
+				log.Printf("[ERROR] %s", err)
+				sweeperErrs = multierror.Append(sweeperErrs, err)
+				continue
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSAppStreamStack_basic(t *testing.T) {
+	var stack appstream.Stack
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_appstream_stack.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(appstream.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppStreamStackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppStreamStackConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamStackExists(resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "access_endpoints.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "application_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "application_settings.0.enabled", "false"),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "appstream", regexp.MustCompile(`stack/.+`)),
+					testAccCheckResourceAttrRfc3339(resourceName, "created_time"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "display_name", ""),
+					resource.TestCheckResourceAttr(resourceName, "embed_host_domains.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "feedback_url", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "redirect_url", ""),
+					resource.TestCheckResourceAttr(resourceName, "storage_connectors.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAppStreamStack_disappears(t *testing.T) {
+	var stack appstream.Stack
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_appstream_stack.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(appstream.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppStreamStackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppStreamStackConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamStackExists(resourceName, &stack),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsAppStreamStack(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAppStreamStack_Tags(t *testing.T) {
+	var stack appstream.Stack
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_appstream_stack.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(appstream.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppStreamStackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppStreamStackConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamStackExists(resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSAppStreamStackConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamStackExists(resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSAppStreamStackConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamStackExists(resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSAppStreamStack_update(t *testing.T) {
+	var stack appstream.Stack
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_appstream_stack.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(appstream.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppStreamStackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppStreamStackConfigComplete(rName, "description1", "https://www.example.com/feedback", "ENABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamStackExists(resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttr(resourceName, "display_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "embed_host_domains.#", "1"),
+					tfawsresource.TestCheckTypeSetElemAttr(resourceName, "embed_host_domains.*", "example.com"),
+					resource.TestCheckResourceAttr(resourceName, "feedback_url", "https://www.example.com/feedback"),
+					resource.TestCheckResourceAttr(resourceName, "redirect_url", "https://www.example.com/redirect"),
+					resource.TestCheckResourceAttr(resourceName, "storage_connectors.#", "1"),
+					tfawsresource.TestCheckTypeSetElemNestedAttrs(resourceName, "storage_connectors.*", map[string]string{
+						"connector_type": appstream.StorageConnectorTypeHomefolders,
+					}),
+					tfawsresource.TestCheckTypeSetElemNestedAttrs(resourceName, "user_settings.*", map[string]string{
+						"action":     appstream.ActionClipboardCopyFromLocalDevice,
+						"permission": "ENABLED",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSAppStreamStackConfigComplete(rName, "description2", "https://www.example.com/feedback2", "DISABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamStackExists(resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+					resource.TestCheckResourceAttr(resourceName, "feedback_url", "https://www.example.com/feedback2"),
+					tfawsresource.TestCheckTypeSetElemNestedAttrs(resourceName, "user_settings.*", map[string]string{
+						"action":     appstream.ActionClipboardCopyFromLocalDevice,
+						"permission": "DISABLED",
+					}),
+				),
+			},
+			{
+				Config: testAccAWSAppStreamStackConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamStackExists(resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "embed_host_domains.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "feedback_url", ""),
+					resource.TestCheckResourceAttr(resourceName, "redirect_url", ""),
+					resource.TestCheckResourceAttr(resourceName, "storage_connectors.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAppStreamStackExists(resourceName string, v *appstream.Stack) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No AppStream Stack ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).appstreamconn
+
+		stack, err := finder.StackByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if stack == nil {
+			return fmt.Errorf("AppStream Stack (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *stack
+
+		return nil
+	}
+}
+
+func testAccCheckAWSAppStreamStackDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).appstreamconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_appstream_stack" {
+			continue
+		}
+
+		stack, err := finder.StackByName(conn, rs.Primary.ID)
+
+		if tfawserr.ErrCodeEquals(err, appstream.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if stack != nil {
+			return fmt.Errorf("AppStream Stack (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSAppStreamStackConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_appstream_stack" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSAppStreamStackConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_appstream_stack" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAWSAppStreamStackConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_appstream_stack" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
+
+func testAccAWSAppStreamStackConfigComplete(rName, description, feedbackURL, clipboardPermission string) string {
+	return fmt.Sprintf(`
+resource "aws_appstream_stack" "test" {
+  name               = %[1]q
+  description        = %[2]q
+  display_name       = %[1]q
+  embed_host_domains = ["example.com"]
+  feedback_url       = %[3]q
+  redirect_url       = "https://www.example.com/redirect"
+
+  storage_connectors {
+    connector_type = "HOMEFOLDERS"
+  }
+
+  user_settings {
+    action     = "CLIPBOARD_COPY_FROM_LOCAL_DEVICE"
+    permission = %[4]q
+  }
+
+  user_settings {
+    action     = "CLIPBOARD_COPY_TO_LOCAL_DEVICE"
+    permission = "ENABLED"
+  }
+
+  user_settings {
+    action     = "FILE_DOWNLOAD"
+    permission = "ENABLED"
+  }
+
+  user_settings {
+    action     = "FILE_UPLOAD"
+    permission = "ENABLED"
+  }
+
+  user_settings {
+    action     = "PRINTING_TO_LOCAL_DEVICE"
+    permission = "ENABLED"
+  }
+}
+`, rName, description, feedbackURL, clipboardPermission)
+}
@@ -0,0 +1,238 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfappstream "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream/finder"
+)
+
+func resourceAwsAppStreamUser() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAppStreamUserCreate,
+		Read:   resourceAwsAppStreamUserRead,
+		Update: resourceAwsAppStreamUserUpdate,
+		Delete: resourceAwsAppStreamUserDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"authentication_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(appstream.AuthenticationType_Values(), false),
+			},
+
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"first_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(0, 2048),
+			},
+
+			"last_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(0, 2048),
+			},
+
+			"send_email_notification": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"user_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+		},
+	}
+}
+
+func resourceAwsAppStreamUserCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appstreamconn
+
+	userName := d.Get("user_name").(string)
+	authenticationType := d.Get("authentication_type").(string)
+	id := tfappstream.UserCreateID(userName, authenticationType)
+
+	input := &appstream.CreateUserInput{
+		AuthenticationType: aws.String(authenticationType),
+		UserName:           aws.String(userName),
+	}
+
+	if v, ok := d.GetOk("first_name"); ok {
+		input.FirstName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("last_name"); ok {
+		input.LastName = aws.String(v.(string))
+	}
+
+	if !d.Get("send_email_notification").(bool) {
+		input.MessageAction = aws.String(appstream.MessageActionSuppress)
+	}
+
+	log.Printf("[DEBUG] Creating AppStream User: %s", input)
+	_, err := conn.CreateUser(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating AppStream User (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	if !d.Get("enabled").(bool) {
+		if err := resourceAwsAppStreamUserDisable(conn, userName, authenticationType); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsAppStreamUserRead(d, meta)
+}
+
+func resourceAwsAppStreamUserRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appstreamconn
+
+	userName, authenticationType, err := tfappstream.UserParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	user, err := finder.UserByNameAndAuthenticationType(conn, userName, authenticationType)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, appstream.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] AppStream User (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading AppStream User (%s): %w", d.Id(), err)
+	}
+
+	if user == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading AppStream User (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] AppStream User (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", user.Arn)
+	d.Set("authentication_type", user.AuthenticationType)
+	d.Set("created_time", aws.TimeValue(user.CreatedTime).Format(time.RFC3339))
+	d.Set("enabled", user.Enabled)
+	d.Set("first_name", user.FirstName)
+	d.Set("last_name", user.LastName)
+	d.Set("user_name", user.UserName)
+
+	return nil
+}
+
+func resourceAwsAppStreamUserUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appstreamconn
+
+	if d.HasChange("enabled") {
+		userName, authenticationType, err := tfappstream.UserParseID(d.Id())
+
+		if err != nil {
+			return err
+		}
+
+		if d.Get("enabled").(bool) {
+			log.Printf("[DEBUG] Enabling AppStream User (%s)", d.Id())
+			_, err := conn.EnableUser(&appstream.EnableUserInput{
+				AuthenticationType: aws.String(authenticationType),
+				UserName:           aws.String(userName),
+			})
+
+			if err != nil {
+				return fmt.Errorf("error enabling AppStream User (%s): %w", d.Id(), err)
+			}
+		} else {
+			if err := resourceAwsAppStreamUserDisable(conn, userName, authenticationType); err != nil {
+				return err
+			}
+		}
+	}
+
+	return resourceAwsAppStreamUserRead(d, meta)
+}
+
+func resourceAwsAppStreamUserDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appstreamconn
+
+	userName, authenticationType, err := tfappstream.UserParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting AppStream User (%s)", d.Id())
+	_, err = conn.DeleteUser(&appstream.DeleteUserInput{
+		AuthenticationType: aws.String(authenticationType),
+		UserName:           aws.String(userName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, appstream.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting AppStream User (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsAppStreamUserDisable(conn *appstream.AppStream, userName, authenticationType string) error {
+	id := tfappstream.UserCreateID(userName, authenticationType)
+
+	log.Printf("[DEBUG] Disabling AppStream User (%s)", id)
+	_, err := conn.DisableUser(&appstream.DisableUserInput{
+		AuthenticationType: aws.String(authenticationType),
+		UserName:           aws.String(userName),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error disabling AppStream User (%s): %w", id, err)
+	}
+
+	return nil
+}
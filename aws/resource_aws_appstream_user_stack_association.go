@@ -0,0 +1,167 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfappstream "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream/finder"
+)
+
+func resourceAwsAppStreamUserStackAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAppStreamUserStackAssociationCreate,
+		Read:   resourceAwsAppStreamUserStackAssociationRead,
+		Delete: resourceAwsAppStreamUserStackAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"authentication_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(appstream.AuthenticationType_Values(), false),
+			},
+
+			"send_email_notification": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"stack_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"user_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+		},
+	}
+}
+
+func resourceAwsAppStreamUserStackAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appstreamconn
+
+	userName := d.Get("user_name").(string)
+	authenticationType := d.Get("authentication_type").(string)
+	stackName := d.Get("stack_name").(string)
+	id := tfappstream.UserStackAssociationCreateID(userName, authenticationType, stackName)
+
+	input := &appstream.BatchAssociateUserStackInput{
+		UserStackAssociations: []*appstream.UserStackAssociation{
+			{
+				AuthenticationType:    aws.String(authenticationType),
+				SendEmailNotification: aws.Bool(d.Get("send_email_notification").(bool)),
+				StackName:             aws.String(stackName),
+				UserName:              aws.String(userName),
+			},
+		},
+	}
+
+	log.Printf("[DEBUG] Creating AppStream User Stack Association: %s", input)
+	output, err := conn.BatchAssociateUserStack(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating AppStream User Stack Association (%s): %w", id, err)
+	}
+
+	if output != nil && len(output.Errors) > 0 {
+		return fmt.Errorf("error creating AppStream User Stack Association (%s): %s: %s", id, aws.StringValue(output.Errors[0].ErrorCode), aws.StringValue(output.Errors[0].ErrorMessage))
+	}
+
+	d.SetId(id)
+
+	return resourceAwsAppStreamUserStackAssociationRead(d, meta)
+}
+
+func resourceAwsAppStreamUserStackAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appstreamconn
+
+	userName, authenticationType, stackName, err := tfappstream.UserStackAssociationParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	association, err := finder.UserStackAssociationByUserNameAuthenticationTypeAndStackName(conn, userName, authenticationType, stackName)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, appstream.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] AppStream User Stack Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading AppStream User Stack Association (%s): %w", d.Id(), err)
+	}
+
+	if association == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading AppStream User Stack Association (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] AppStream User Stack Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("authentication_type", association.AuthenticationType)
+	d.Set("stack_name", association.StackName)
+	d.Set("user_name", association.UserName)
+
+	return nil
+}
+
+func resourceAwsAppStreamUserStackAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appstreamconn
+
+	userName, authenticationType, stackName, err := tfappstream.UserStackAssociationParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting AppStream User Stack Association (%s)", d.Id())
+	output, err := conn.BatchDisassociateUserStack(&appstream.BatchDisassociateUserStackInput{
+		UserStackAssociations: []*appstream.UserStackAssociation{
+			{
+				AuthenticationType: aws.String(authenticationType),
+				StackName:          aws.String(stackName),
+				UserName:           aws.String(userName),
+			},
+		},
+	})
+
+	if tfawserr.ErrCodeEquals(err, appstream.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting AppStream User Stack Association (%s): %w", d.Id(), err)
+	}
+
+	if output != nil && len(output.Errors) > 0 {
+		switch aws.StringValue(output.Errors[0].ErrorCode) {
+		case appstream.UserStackAssociationErrorCodeStackNotFound, appstream.UserStackAssociationErrorCodeUserNameNotFound:
+			return nil
+		}
+
+		return fmt.Errorf("error deleting AppStream User Stack Association (%s): %s: %s", d.Id(), aws.StringValue(output.Errors[0].ErrorCode), aws.StringValue(output.Errors[0].ErrorMessage))
+	}
+
+	return nil
+}
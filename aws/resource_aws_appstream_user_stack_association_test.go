@@ -0,0 +1,150 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfappstream "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream/finder"
+)
+
+func TestAccAWSAppStreamUserStackAssociation_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_appstream_user_stack_association.test"
+	userName := fmt.Sprintf("%s@example.com", rName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(appstream.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppStreamUserStackAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppStreamUserStackAssociationConfig(rName, userName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamUserStackAssociationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "authentication_type", "aws_appstream_user.test", "authentication_type"),
+					resource.TestCheckResourceAttr(resourceName, "send_email_notification", "false"),
+					resource.TestCheckResourceAttrPair(resourceName, "stack_name", "aws_appstream_stack.test", "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "user_name", "aws_appstream_user.test", "user_name"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"send_email_notification"},
+			},
+		},
+	})
+}
+
+func TestAccAWSAppStreamUserStackAssociation_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_appstream_user_stack_association.test"
+	userName := fmt.Sprintf("%s@example.com", rName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(appstream.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppStreamUserStackAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppStreamUserStackAssociationConfig(rName, userName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamUserStackAssociationExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsAppStreamUserStackAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAppStreamUserStackAssociationExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No AppStream User Stack Association ID is set")
+		}
+
+		userName, authenticationType, stackName, err := tfappstream.UserStackAssociationParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).appstreamconn
+
+		association, err := finder.UserStackAssociationByUserNameAuthenticationTypeAndStackName(conn, userName, authenticationType, stackName)
+
+		if err != nil {
+			return err
+		}
+
+		if association == nil {
+			return fmt.Errorf("AppStream User Stack Association (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSAppStreamUserStackAssociationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).appstreamconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_appstream_user_stack_association" {
+			continue
+		}
+
+		userName, authenticationType, stackName, err := tfappstream.UserStackAssociationParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		association, err := finder.UserStackAssociationByUserNameAuthenticationTypeAndStackName(conn, userName, authenticationType, stackName)
+
+		if tfawserr.ErrCodeEquals(err, appstream.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if association != nil {
+			return fmt.Errorf("AppStream User Stack Association (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSAppStreamUserStackAssociationConfig(rName, userName string) string {
+	return fmt.Sprintf(`
+resource "aws_appstream_stack" "test" {
+  name = %[1]q
+}
+
+resource "aws_appstream_user" "test" {
+  authentication_type = "USERPOOL"
+  user_name           = %[2]q
+}
+
+resource "aws_appstream_user_stack_association" "test" {
+  authentication_type = aws_appstream_user.test.authentication_type
+  stack_name          = aws_appstream_stack.test.name
+  user_name           = aws_appstream_user.test.user_name
+}
+`, rName, userName)
+}
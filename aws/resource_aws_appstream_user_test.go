@@ -0,0 +1,190 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfappstream "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream/finder"
+)
+
+func TestAccAWSAppStreamUser_basic(t *testing.T) {
+	var user appstream.User
+	resourceName := "aws_appstream_user.test"
+	userName := fmt.Sprintf("%s@example.com", acctest.RandomWithPrefix("tf-acc-test"))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(appstream.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppStreamUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppStreamUserConfig(userName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamUserExists(resourceName, &user),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "appstream", regexp.MustCompile(`user/userpool/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "authentication_type", appstream.AuthenticationTypeUserpool),
+					testAccCheckResourceAttrRfc3339(resourceName, "created_time"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "first_name", "first"),
+					resource.TestCheckResourceAttr(resourceName, "last_name", "last"),
+					resource.TestCheckResourceAttr(resourceName, "user_name", userName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"send_email_notification"},
+			},
+		},
+	})
+}
+
+func TestAccAWSAppStreamUser_disappears(t *testing.T) {
+	var user appstream.User
+	resourceName := "aws_appstream_user.test"
+	userName := fmt.Sprintf("%s@example.com", acctest.RandomWithPrefix("tf-acc-test"))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(appstream.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppStreamUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppStreamUserConfig(userName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamUserExists(resourceName, &user),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsAppStreamUser(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAppStreamUser_Enabled(t *testing.T) {
+	var user appstream.User
+	resourceName := "aws_appstream_user.test"
+	userName := fmt.Sprintf("%s@example.com", acctest.RandomWithPrefix("tf-acc-test"))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(appstream.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppStreamUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppStreamUserConfig(userName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamUserExists(resourceName, &user),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"send_email_notification"},
+			},
+			{
+				Config: testAccAWSAppStreamUserConfig(userName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamUserExists(resourceName, &user),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+				),
+			},
+			{
+				Config: testAccAWSAppStreamUserConfig(userName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamUserExists(resourceName, &user),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAppStreamUserExists(resourceName string, v *appstream.User) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No AppStream User ID is set")
+		}
+
+		userName, authenticationType, err := tfappstream.UserParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).appstreamconn
+
+		user, err := finder.UserByNameAndAuthenticationType(conn, userName, authenticationType)
+
+		if err != nil {
+			return err
+		}
+
+		if user == nil {
+			return fmt.Errorf("AppStream User (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *user
+
+		return nil
+	}
+}
+
+func testAccCheckAWSAppStreamUserDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).appstreamconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_appstream_user" {
+			continue
+		}
+
+		userName, authenticationType, err := tfappstream.UserParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		user, err := finder.UserByNameAndAuthenticationType(conn, userName, authenticationType)
+
+		if tfawserr.ErrCodeEquals(err, appstream.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if user != nil {
+			return fmt.Errorf("AppStream User (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSAppStreamUserConfig(userName string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_appstream_user" "test" {
+  authentication_type = "USERPOOL"
+  user_name           = %[1]q
+  first_name          = "first"
+  last_name           = "last"
+  enabled             = %[2]t
+}
+`, userName, enabled)
+}
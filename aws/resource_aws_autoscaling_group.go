@@ -15,12 +15,14 @@ import (
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/elb"
 	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/hashcode"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/autoscaling/waiter"
 )
 
 const (
@@ -38,6 +40,7 @@ func resourceAwsAutoscalingGroup() *schema.Resource {
 		},
 
 		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
@@ -472,6 +475,57 @@ func resourceAwsAutoscalingGroup() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+
+			"instance_refresh": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"strategy": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(autoscaling.RefreshStrategy_Values(), false),
+						},
+						"preferences": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									// A string is used so that an unset value can be distinguished from 0,
+									// which would disable the warmup period entirely.
+									"instance_warmup": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateTypeStringNullableIntAtLeast(0),
+									},
+									"min_healthy_percentage": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      90,
+										ValidateFunc: validation.IntBetween(0, 100),
+									},
+								},
+							},
+						},
+						"triggers": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateAutoScalingGroupInstanceRefreshTriggerFields,
+							},
+						},
+					},
+				},
+			},
+
+			"wait_for_instance_refresh": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 
 		CustomizeDiff: customdiff.Sequence(
@@ -900,6 +954,7 @@ func waitUntilAutoscalingGroupLoadBalancerTargetGroupsAdded(conn *autoscaling.Au
 func resourceAwsAutoscalingGroupUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).autoscalingconn
 	shouldWaitForCapacity := false
+	shouldRefreshInstances := false
 
 	opts := autoscaling.UpdateAutoScalingGroupInput{
 		AutoScalingGroupName: aws.String(d.Id()),
@@ -920,16 +975,19 @@ func resourceAwsAutoscalingGroupUpdate(d *schema.ResourceData, meta interface{})
 		if v, ok := d.GetOk("launch_configuration"); ok {
 			opts.LaunchConfigurationName = aws.String(v.(string))
 		}
+		shouldRefreshInstances = true
 	}
 
 	if d.HasChange("launch_template") {
 		if v, ok := d.GetOk("launch_template"); ok && len(v.([]interface{})) > 0 {
 			opts.LaunchTemplate, _ = expandLaunchTemplateSpecification(v.([]interface{}))
 		}
+		shouldRefreshInstances = true
 	}
 
 	if d.HasChange("mixed_instances_policy") {
 		opts.MixedInstancesPolicy = expandAutoScalingMixedInstancesPolicy(d.Get("mixed_instances_policy").([]interface{}))
+		shouldRefreshInstances = true
 	}
 
 	if d.HasChange("min_size") {
@@ -1163,9 +1221,82 @@ func resourceAwsAutoscalingGroupUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
+	if v, ok := d.GetOk("instance_refresh"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		if !shouldRefreshInstances {
+			if triggers, ok := tfMap["triggers"].(*schema.Set); ok && triggers.Len() > 0 {
+				shouldRefreshInstances = d.HasChanges(expandAutoScalingGroupInstanceRefreshTriggers(triggers)...)
+			}
+		}
+
+		if shouldRefreshInstances {
+			id, err := autoScalingGroupRefreshInstances(conn, d.Id(), tfMap)
+
+			if err != nil {
+				return fmt.Errorf("error starting Auto Scaling Group (%s) instance refresh: %w", d.Id(), err)
+			}
+
+			if d.Get("wait_for_instance_refresh").(bool) {
+				if _, err := waiter.InstanceRefreshSuccessful(conn, d.Id(), id, d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return fmt.Errorf("error waiting for Auto Scaling Group (%s) instance refresh (%s) to complete: %w", d.Id(), id, err)
+				}
+			}
+		}
+	}
+
 	return resourceAwsAutoscalingGroupRead(d, meta)
 }
 
+// autoScalingGroupRefreshInstances cancels any in-progress instance refresh of the Auto Scaling Group
+// and starts a new one, returning the ID of the started instance refresh.
+func autoScalingGroupRefreshInstances(conn *autoscaling.AutoScaling, asgName string, tfMap map[string]interface{}) (string, error) {
+	if err := cancelAutoScalingGroupInstanceRefresh(conn, asgName); err != nil {
+		return "", err
+	}
+
+	input := &autoscaling.StartInstanceRefreshInput{
+		AutoScalingGroupName: aws.String(asgName),
+		Strategy:             aws.String(tfMap["strategy"].(string)),
+		Preferences:          expandAutoScalingGroupInstanceRefreshPreferences(tfMap["preferences"].([]interface{})),
+	}
+
+	log.Printf("[DEBUG] Starting Auto Scaling Group instance refresh: %s", input)
+	output, err := conn.StartInstanceRefresh(input)
+
+	if err != nil {
+		return "", err
+	}
+
+	id := aws.StringValue(output.InstanceRefreshId)
+	log.Printf("[INFO] Started Auto Scaling Group (%s) instance refresh (%s)", asgName, id)
+
+	return id, nil
+}
+
+func cancelAutoScalingGroupInstanceRefresh(conn *autoscaling.AutoScaling, asgName string) error {
+	log.Printf("[DEBUG] Cancelling any in-progress Auto Scaling Group (%s) instance refresh", asgName)
+	output, err := conn.CancelInstanceRefresh(&autoscaling.CancelInstanceRefreshInput{
+		AutoScalingGroupName: aws.String(asgName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, autoscaling.ErrCodeActiveInstanceRefreshNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error cancelling instance refresh: %w", err)
+	}
+
+	id := aws.StringValue(output.InstanceRefreshId)
+
+	if _, err := waiter.InstanceRefreshCancelled(conn, asgName, id); err != nil {
+		return fmt.Errorf("error waiting for instance refresh (%s) to be cancelled: %w", id, err)
+	}
+
+	return nil
+}
+
 func resourceAwsAutoscalingGroupDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).autoscalingconn
 
@@ -1491,6 +1622,62 @@ func getTargetGroupInstanceStates(g *autoscaling.Group, meta interface{}) (map[s
 	return targetInstanceStates, nil
 }
 
+func expandAutoScalingGroupInstanceRefreshPreferences(l []interface{}) *autoscaling.RefreshPreferences {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	refreshPreferences := &autoscaling.RefreshPreferences{}
+
+	if v, ok := m["instance_warmup"].(string); ok && v != "" {
+		i, _ := strconv.Atoi(v)
+		refreshPreferences.InstanceWarmup = aws.Int64(int64(i))
+	}
+
+	if v, ok := m["min_healthy_percentage"].(int); ok {
+		refreshPreferences.MinHealthyPercentage = aws.Int64(int64(v))
+	}
+
+	return refreshPreferences
+}
+
+// expandAutoScalingGroupInstanceRefreshTriggers returns the attribute names whose changes trigger an instance refresh.
+// The "tag" and "tags" attributes are interchangeable.
+func expandAutoScalingGroupInstanceRefreshTriggers(s *schema.Set) []string {
+	triggers := expandStringSet(s)
+	attributes := make([]string, 0, len(triggers)+1)
+
+	for _, trigger := range triggers {
+		attributes = append(attributes, aws.StringValue(trigger))
+	}
+
+	if s.Contains("tag") && !s.Contains("tags") {
+		attributes = append(attributes, "tags")
+	} else if s.Contains("tags") && !s.Contains("tag") {
+		attributes = append(attributes, "tag")
+	}
+
+	return attributes
+}
+
+func validateAutoScalingGroupInstanceRefreshTriggerFields(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	switch value {
+	case "instance_refresh", "wait_for_instance_refresh":
+		errors = append(errors, fmt.Errorf("%q cannot contain %q", k, value))
+		return
+	}
+
+	if _, ok := resourceAwsAutoscalingGroup().Schema[value]; !ok {
+		errors = append(errors, fmt.Errorf("%q contains %q, which is not an attribute of an Auto Scaling Group", k, value))
+	}
+
+	return
+}
+
 func expandVpcZoneIdentifiers(list []interface{}) *string {
 	strs := make([]string, len(list))
 	for _, s := range list {
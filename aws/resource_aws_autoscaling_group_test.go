@@ -785,6 +785,149 @@ func TestAccAWSAutoScalingGroup_MaxInstanceLifetime(t *testing.T) {
 	})
 }
 
+func TestAccAWSAutoScalingGroup_InstanceRefresh_Basic(t *testing.T) {
+	var group autoscaling.Group
+	resourceName := "aws_autoscaling_group.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAutoScalingGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAutoScalingGroupConfig_InstanceRefresh_Basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAutoScalingGroupExists(resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "instance_refresh.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "instance_refresh.0.strategy", "Rolling"),
+					resource.TestCheckResourceAttr(resourceName, "instance_refresh.0.preferences.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "instance_refresh.0.triggers.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_instance_refresh", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"force_delete",
+					"instance_refresh",
+					"wait_for_capacity_timeout",
+					"wait_for_instance_refresh",
+				},
+			},
+			{
+				Config: testAccAWSAutoScalingGroupConfig_InstanceRefresh_Full(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAutoScalingGroupExists(resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "instance_refresh.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "instance_refresh.0.strategy", "Rolling"),
+					resource.TestCheckResourceAttr(resourceName, "instance_refresh.0.preferences.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "instance_refresh.0.preferences.0.instance_warmup", "10"),
+					resource.TestCheckResourceAttr(resourceName, "instance_refresh.0.preferences.0.min_healthy_percentage", "50"),
+					resource.TestCheckResourceAttr(resourceName, "instance_refresh.0.triggers.#", "1"),
+					tfawsresource.TestCheckTypeSetElemAttr(resourceName, "instance_refresh.0.triggers.*", "tags"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_instance_refresh", "true"),
+				),
+			},
+			{
+				Config: testAccAWSAutoScalingGroupConfig_InstanceRefresh_Disabled(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAutoScalingGroupExists(resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "instance_refresh.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSAutoScalingGroup_InstanceRefresh_Start(t *testing.T) {
+	var group autoscaling.Group
+	resourceName := "aws_autoscaling_group.test"
+	launchConfigurationResourceName := "aws_launch_configuration.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAutoScalingGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAutoScalingGroupConfig_InstanceRefresh_Start(rName, "one"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAutoScalingGroupExists(resourceName, &group),
+					resource.TestCheckResourceAttrPair(resourceName, "launch_configuration", launchConfigurationResourceName+".0", "name"),
+					testAccCheckAutoscalingInstanceRefreshCount(&group, 0),
+				),
+			},
+			{
+				Config: testAccAWSAutoScalingGroupConfig_InstanceRefresh_Start(rName, "two"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAutoScalingGroupExists(resourceName, &group),
+					resource.TestCheckResourceAttrPair(resourceName, "launch_configuration", launchConfigurationResourceName+".1", "name"),
+					testAccCheckAutoscalingInstanceRefreshCount(&group, 1),
+					testAccCheckAutoscalingInstanceRefreshStatus(&group, 0, autoscaling.InstanceRefreshStatusSuccessful),
+				),
+			},
+			{
+				Config: testAccAWSAutoScalingGroupConfig_InstanceRefresh_Start(rName, "three"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAutoScalingGroupExists(resourceName, &group),
+					resource.TestCheckResourceAttrPair(resourceName, "launch_configuration", launchConfigurationResourceName+".2", "name"),
+					testAccCheckAutoscalingInstanceRefreshCount(&group, 2),
+					testAccCheckAutoscalingInstanceRefreshStatus(&group, 0, autoscaling.InstanceRefreshStatusSuccessful),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSAutoScalingGroup_InstanceRefresh_Triggers(t *testing.T) {
+	var group autoscaling.Group
+	resourceName := "aws_autoscaling_group.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAutoScalingGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAutoScalingGroupConfig_InstanceRefresh_Triggers(rName, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAutoScalingGroupExists(resourceName, &group),
+					testAccCheckAutoscalingInstanceRefreshCount(&group, 0),
+				),
+			},
+			{
+				Config: testAccAWSAutoScalingGroupConfig_InstanceRefresh_Triggers(rName, "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAutoScalingGroupExists(resourceName, &group),
+					testAccCheckAutoscalingInstanceRefreshCount(&group, 1),
+					testAccCheckAutoscalingInstanceRefreshStatus(&group, 0, autoscaling.InstanceRefreshStatusSuccessful),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSAutoScalingGroup_InstanceRefresh_InvalidTrigger(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAutoScalingGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSAutoScalingGroupConfig_InstanceRefresh_InvalidTrigger(rName),
+				ExpectError: regexp.MustCompile(`not an attribute of an Auto Scaling Group`),
+			},
+		},
+	})
+}
+
 func TestAccAWSAutoScalingGroup_ALB_TargetGroups(t *testing.T) {
 	var group autoscaling.Group
 	var tg elbv2.TargetGroup
@@ -1028,6 +1171,55 @@ func testAccCheckAWSAutoScalingGroupDestroy(s *terraform.State) error {
 	return nil
 }
 
+func testAccCheckAutoscalingInstanceRefreshCount(group *autoscaling.Group, expected int) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).autoscalingconn
+
+		output, err := conn.DescribeInstanceRefreshes(&autoscaling.DescribeInstanceRefreshesInput{
+			AutoScalingGroupName: group.AutoScalingGroupName,
+		})
+
+		if err != nil {
+			return fmt.Errorf("error describing Auto Scaling Group (%s) Instance Refreshes: %w", aws.StringValue(group.AutoScalingGroupName), err)
+		}
+
+		if len(output.InstanceRefreshes) != expected {
+			return fmt.Errorf("expected %d Instance Refreshes, got %d", expected, len(output.InstanceRefreshes))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAutoscalingInstanceRefreshStatus(group *autoscaling.Group, offset int, expected ...string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).autoscalingconn
+
+		output, err := conn.DescribeInstanceRefreshes(&autoscaling.DescribeInstanceRefreshesInput{
+			AutoScalingGroupName: group.AutoScalingGroupName,
+		})
+
+		if err != nil {
+			return fmt.Errorf("error describing Auto Scaling Group (%s) Instance Refreshes: %w", aws.StringValue(group.AutoScalingGroupName), err)
+		}
+
+		if len(output.InstanceRefreshes) <= offset {
+			return fmt.Errorf("expected at least %d Instance Refreshes, got %d", offset+1, len(output.InstanceRefreshes))
+		}
+
+		// Instance Refreshes are returned most recent first.
+		status := aws.StringValue(output.InstanceRefreshes[offset].Status)
+
+		for _, v := range expected {
+			if status == v {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("expected Instance Refresh at index %d to be in %q, got %q", offset, expected, status)
+	}
+}
+
 func testAccCheckAWSAutoScalingGroupAttributes(group *autoscaling.Group, name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if *group.AutoScalingGroupName != name {
@@ -4152,3 +4344,178 @@ resource "aws_autoscaling_group" "test" {
 }
 `, rName)
 }
+
+func testAccAWSAutoScalingGroupConfig_InstanceRefresh_Base(rName string) string {
+	return composeConfig(
+		testAccAvailableAZsNoOptInDefaultExcludeConfig(),
+		fmt.Sprintf(`
+data "aws_ami" "test" {
+  most_recent = true
+  owners      = ["amazon"]
+
+  filter {
+    name   = "name"
+    values = ["amzn-ami-hvm-*-x86_64-gp2"]
+  }
+}
+
+resource "aws_launch_configuration" "test" {
+  name_prefix   = %[1]q
+  image_id      = data.aws_ami.test.id
+  instance_type = "t3.nano"
+}
+`, rName))
+}
+
+func testAccAWSAutoScalingGroupConfig_InstanceRefresh_Basic(rName string) string {
+	return composeConfig(
+		testAccAWSAutoScalingGroupConfig_InstanceRefresh_Base(rName),
+		fmt.Sprintf(`
+resource "aws_autoscaling_group" "test" {
+  availability_zones   = [data.aws_availability_zones.available.names[0]]
+  name                 = %[1]q
+  max_size             = 2
+  min_size             = 1
+  desired_capacity     = 1
+  launch_configuration = aws_launch_configuration.test.name
+
+  instance_refresh {
+    strategy = "Rolling"
+  }
+}
+`, rName))
+}
+
+func testAccAWSAutoScalingGroupConfig_InstanceRefresh_Full(rName string) string {
+	return composeConfig(
+		testAccAWSAutoScalingGroupConfig_InstanceRefresh_Base(rName),
+		fmt.Sprintf(`
+resource "aws_autoscaling_group" "test" {
+  availability_zones   = [data.aws_availability_zones.available.names[0]]
+  name                 = %[1]q
+  max_size             = 2
+  min_size             = 1
+  desired_capacity     = 1
+  launch_configuration = aws_launch_configuration.test.name
+
+  instance_refresh {
+    strategy = "Rolling"
+
+    preferences {
+      instance_warmup        = 10
+      min_healthy_percentage = 50
+    }
+
+    triggers = ["tags"]
+  }
+
+  wait_for_instance_refresh = true
+}
+`, rName))
+}
+
+func testAccAWSAutoScalingGroupConfig_InstanceRefresh_Disabled(rName string) string {
+	return composeConfig(
+		testAccAWSAutoScalingGroupConfig_InstanceRefresh_Base(rName),
+		fmt.Sprintf(`
+resource "aws_autoscaling_group" "test" {
+  availability_zones   = [data.aws_availability_zones.available.names[0]]
+  name                 = %[1]q
+  max_size             = 2
+  min_size             = 1
+  desired_capacity     = 1
+  launch_configuration = aws_launch_configuration.test.name
+}
+`, rName))
+}
+
+func testAccAWSAutoScalingGroupConfig_InstanceRefresh_Start(rName, launchConfigurationName string) string {
+	return composeConfig(
+		testAccAvailableAZsNoOptInDefaultExcludeConfig(),
+		fmt.Sprintf(`
+data "aws_ami" "test" {
+  most_recent = true
+  owners      = ["amazon"]
+
+  filter {
+    name   = "name"
+    values = ["amzn-ami-hvm-*-x86_64-gp2"]
+  }
+}
+
+locals {
+  launch_configurations = ["one", "two", "three"]
+}
+
+resource "aws_launch_configuration" "test" {
+  count = length(local.launch_configurations)
+
+  name_prefix   = format("%%s-%%s", %[1]q, local.launch_configurations[count.index])
+  image_id      = data.aws_ami.test.id
+  instance_type = "t3.nano"
+}
+
+resource "aws_autoscaling_group" "test" {
+  availability_zones   = [data.aws_availability_zones.available.names[0]]
+  name                 = %[1]q
+  max_size             = 2
+  min_size             = 1
+  desired_capacity     = 1
+  launch_configuration = aws_launch_configuration.test[index(local.launch_configurations, %[2]q)].name
+
+  instance_refresh {
+    strategy = "Rolling"
+  }
+
+  wait_for_instance_refresh = true
+}
+`, rName, launchConfigurationName))
+}
+
+func testAccAWSAutoScalingGroupConfig_InstanceRefresh_Triggers(rName, tagValue string) string {
+	return composeConfig(
+		testAccAWSAutoScalingGroupConfig_InstanceRefresh_Base(rName),
+		fmt.Sprintf(`
+resource "aws_autoscaling_group" "test" {
+  availability_zones   = [data.aws_availability_zones.available.names[0]]
+  name                 = %[1]q
+  max_size             = 2
+  min_size             = 1
+  desired_capacity     = 1
+  launch_configuration = aws_launch_configuration.test.name
+
+  instance_refresh {
+    strategy = "Rolling"
+    triggers = ["tag"]
+  }
+
+  wait_for_instance_refresh = true
+
+  tag {
+    key                 = "Key"
+    value               = %[2]q
+    propagate_at_launch = true
+  }
+}
+`, rName, tagValue))
+}
+
+func testAccAWSAutoScalingGroupConfig_InstanceRefresh_InvalidTrigger(rName string) string {
+	return composeConfig(
+		testAccAWSAutoScalingGroupConfig_InstanceRefresh_Base(rName),
+		fmt.Sprintf(`
+resource "aws_autoscaling_group" "test" {
+  availability_zones   = [data.aws_availability_zones.available.names[0]]
+  name                 = %[1]q
+  max_size             = 2
+  min_size             = 1
+  desired_capacity     = 1
+  launch_configuration = aws_launch_configuration.test.name
+
+  instance_refresh {
+    strategy = "Rolling"
+    triggers = ["not_an_attribute"]
+  }
+}
+`, rName))
+}
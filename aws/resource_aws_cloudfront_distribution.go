@@ -1,13 +1,16 @@
 package aws
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -32,6 +35,11 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 		MigrateState:  resourceAwsCloudFrontDistributionMigrateState,
 		SchemaVersion: 1,
 
+		CustomizeDiff: customdiff.Sequence(
+			SetTagsDiff,
+			resourceAwsCloudFrontDistributionCustomizeDiff,
+		),
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -58,15 +66,20 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 							Required: true,
 							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
+						"cache_policy_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
 						"compress": {
 							Type:     schema.TypeBool,
 							Optional: true,
 							Default:  false,
 						},
 						"default_ttl": {
-							Type:     schema.TypeInt,
-							Optional: true,
-							Default:  86400,
+							Type:             schema.TypeInt,
+							Optional:         true,
+							Default:          86400,
+							DiffSuppressFunc: suppressCloudFrontCacheBehaviorTTLDiffs,
 						},
 						"field_level_encryption_id": {
 							Type:     schema.TypeString,
@@ -74,7 +87,7 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 						},
 						"forwarded_values": {
 							Type:     schema.TypeList,
-							Required: true,
+							Optional: true,
 							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
@@ -142,14 +155,25 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 							Set: lambdaFunctionAssociationHash,
 						},
 						"max_ttl": {
-							Type:     schema.TypeInt,
-							Optional: true,
-							Default:  31536000,
+							Type:             schema.TypeInt,
+							Optional:         true,
+							Default:          31536000,
+							DiffSuppressFunc: suppressCloudFrontCacheBehaviorTTLDiffs,
 						},
 						"min_ttl": {
-							Type:     schema.TypeInt,
+							Type:             schema.TypeInt,
+							Optional:         true,
+							Default:          0,
+							DiffSuppressFunc: suppressCloudFrontCacheBehaviorTTLDiffs,
+						},
+						"origin_request_policy_id": {
+							Type:     schema.TypeString,
 							Optional: true,
-							Default:  0,
+						},
+						"realtime_log_config_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateArn,
 						},
 						"path_pattern": {
 							Type:     schema.TypeString,
@@ -220,15 +244,20 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 							Required: true,
 							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
+						"cache_policy_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
 						"compress": {
 							Type:     schema.TypeBool,
 							Optional: true,
 							Default:  false,
 						},
 						"default_ttl": {
-							Type:     schema.TypeInt,
-							Optional: true,
-							Default:  86400,
+							Type:             schema.TypeInt,
+							Optional:         true,
+							Default:          86400,
+							DiffSuppressFunc: suppressCloudFrontCacheBehaviorTTLDiffs,
 						},
 						"field_level_encryption_id": {
 							Type:     schema.TypeString,
@@ -236,7 +265,7 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 						},
 						"forwarded_values": {
 							Type:     schema.TypeList,
-							Required: true,
+							Optional: true,
 							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
@@ -304,14 +333,25 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 							Set: lambdaFunctionAssociationHash,
 						},
 						"max_ttl": {
-							Type:     schema.TypeInt,
-							Optional: true,
-							Default:  31536000,
+							Type:             schema.TypeInt,
+							Optional:         true,
+							Default:          31536000,
+							DiffSuppressFunc: suppressCloudFrontCacheBehaviorTTLDiffs,
 						},
 						"min_ttl": {
-							Type:     schema.TypeInt,
+							Type:             schema.TypeInt,
+							Optional:         true,
+							Default:          0,
+							DiffSuppressFunc: suppressCloudFrontCacheBehaviorTTLDiffs,
+						},
+						"origin_request_policy_id": {
+							Type:     schema.TypeString,
 							Optional: true,
-							Default:  0,
+						},
+						"realtime_log_config_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateArn,
 						},
 						"smooth_streaming": {
 							Type:     schema.TypeBool,
@@ -651,18 +691,21 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 				Default:  false,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
 
 func resourceAwsCloudFrontDistributionCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cloudfrontconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
 
 	params := &cloudfront.CreateDistributionWithTagsInput{
 		DistributionConfigWithTags: &cloudfront.DistributionConfigWithTags{
 			DistributionConfig: expandDistributionConfig(d),
-			Tags:               &cloudfront.Tags{Items: keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreAws().CloudfrontTags()},
+			Tags:               &cloudfront.Tags{Items: tags.IgnoreAws().CloudfrontTags()},
 		},
 	}
 
@@ -708,6 +751,7 @@ func resourceAwsCloudFrontDistributionCreate(d *schema.ResourceData, meta interf
 
 func resourceAwsCloudFrontDistributionRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cloudfrontconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	params := &cloudfront.GetDistributionInput{
@@ -746,10 +790,17 @@ func resourceAwsCloudFrontDistributionRead(d *schema.ResourceData, meta interfac
 	if err != nil {
 		return fmt.Errorf("error listing tags for CloudFront Distribution (%s): %s", d.Id(), err)
 	}
-	if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %s", err)
+	}
+
 	return nil
 }
 
@@ -794,8 +845,8 @@ func resourceAwsCloudFrontDistributionUpdate(d *schema.ResourceData, meta interf
 		}
 	}
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 		if err := keyvaluetags.CloudfrontUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
 			return fmt.Errorf("error updating tags for CloudFront Distribution (%s): %s", d.Id(), err)
 		}
@@ -970,6 +1021,50 @@ func resourceAwsCloudFrontDistributionDelete(d *schema.ResourceData, meta interf
 	return nil
 }
 
+// resourceAwsCloudFrontDistributionCustomizeDiff ensures that each cache
+// behavior is configured with exactly one of a cache policy or the legacy
+// forwarded values.
+func resourceAwsCloudFrontDistributionCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	prefixes := []string{"default_cache_behavior.0"}
+	for i := range diff.Get("ordered_cache_behavior").([]interface{}) {
+		prefixes = append(prefixes, fmt.Sprintf("ordered_cache_behavior.%d", i))
+	}
+
+	for _, prefix := range prefixes {
+		cachePolicyIDKey := prefix + ".cache_policy_id"
+
+		// The cache policy may not be created yet.
+		if !diff.NewValueKnown(cachePolicyIDKey) {
+			continue
+		}
+
+		cachePolicyID := diff.Get(cachePolicyIDKey).(string)
+		forwardedValues := diff.Get(prefix + ".forwarded_values").([]interface{})
+
+		if cachePolicyID != "" && len(forwardedValues) > 0 {
+			return fmt.Errorf("%s: only one of cache_policy_id or forwarded_values can be specified", prefix)
+		}
+
+		if cachePolicyID == "" && len(forwardedValues) == 0 {
+			return fmt.Errorf("%s: one of cache_policy_id or forwarded_values must be specified", prefix)
+		}
+	}
+
+	return nil
+}
+
+// suppressCloudFrontCacheBehaviorTTLDiffs suppresses differences in the
+// legacy cache behavior TTL settings when a cache policy is attached, as the
+// TTLs are then determined by the cache policy.
+func suppressCloudFrontCacheBehaviorTTLDiffs(k, old, new string, d *schema.ResourceData) bool {
+	i := strings.LastIndex(k, ".")
+	if i < 0 {
+		return false
+	}
+
+	return d.Get(k[:i]+".cache_policy_id").(string) != ""
+}
+
 // resourceAwsCloudFrontWebDistributionWaitUntilDeployed blocks until the
 // distribution is deployed. It currently takes exactly 15 minutes to deploy
 // but that might change in the future.
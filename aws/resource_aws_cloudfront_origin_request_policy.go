@@ -0,0 +1,262 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAwsCloudFrontOriginRequestPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCloudFrontOriginRequestPolicyCreate,
+		Read:   resourceAwsCloudFrontOriginRequestPolicyRead,
+		Update: resourceAwsCloudFrontOriginRequestPolicyUpdate,
+		Delete: resourceAwsCloudFrontOriginRequestPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"comment": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"cookies_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cookie_behavior": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(cloudfront.OriginRequestPolicyCookieBehavior_Values(), false),
+						},
+						"cookies": cloudFrontPolicyItemsSchema(),
+					},
+				},
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"headers_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"header_behavior": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(cloudfront.OriginRequestPolicyHeaderBehavior_Values(), false),
+						},
+						"headers": cloudFrontPolicyItemsSchema(),
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"query_strings_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"query_string_behavior": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(cloudfront.OriginRequestPolicyQueryStringBehavior_Values(), false),
+						},
+						"query_strings": cloudFrontPolicyItemsSchema(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsCloudFrontOriginRequestPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	name := d.Get("name").(string)
+	input := &cloudfront.CreateOriginRequestPolicyInput{
+		OriginRequestPolicyConfig: expandCloudFrontOriginRequestPolicyConfig(d),
+	}
+
+	log.Printf("[DEBUG] Creating CloudFront Origin Request Policy: %s", input)
+	output, err := conn.CreateOriginRequestPolicy(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating CloudFront Origin Request Policy (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.OriginRequestPolicy.Id))
+
+	return resourceAwsCloudFrontOriginRequestPolicyRead(d, meta)
+}
+
+func resourceAwsCloudFrontOriginRequestPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	output, err := conn.GetOriginRequestPolicy(&cloudfront.GetOriginRequestPolicyInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeNoSuchOriginRequestPolicy) {
+		log.Printf("[WARN] CloudFront Origin Request Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading CloudFront Origin Request Policy (%s): %w", d.Id(), err)
+	}
+
+	if output == nil || output.OriginRequestPolicy == nil || output.OriginRequestPolicy.OriginRequestPolicyConfig == nil {
+		return fmt.Errorf("error reading CloudFront Origin Request Policy (%s): empty response", d.Id())
+	}
+
+	d.Set("etag", output.ETag)
+
+	return setCloudFrontOriginRequestPolicyConfig(d, output.OriginRequestPolicy.OriginRequestPolicyConfig)
+}
+
+func resourceAwsCloudFrontOriginRequestPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	input := &cloudfront.UpdateOriginRequestPolicyInput{
+		Id:                        aws.String(d.Id()),
+		IfMatch:                   aws.String(d.Get("etag").(string)),
+		OriginRequestPolicyConfig: expandCloudFrontOriginRequestPolicyConfig(d),
+	}
+
+	log.Printf("[DEBUG] Updating CloudFront Origin Request Policy: %s", input)
+	_, err := conn.UpdateOriginRequestPolicy(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating CloudFront Origin Request Policy (%s): %w", d.Id(), err)
+	}
+
+	return resourceAwsCloudFrontOriginRequestPolicyRead(d, meta)
+}
+
+func resourceAwsCloudFrontOriginRequestPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	log.Printf("[DEBUG] Deleting CloudFront Origin Request Policy: %s", d.Id())
+	_, err := conn.DeleteOriginRequestPolicy(&cloudfront.DeleteOriginRequestPolicyInput{
+		Id:      aws.String(d.Id()),
+		IfMatch: aws.String(d.Get("etag").(string)),
+	})
+
+	if tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeNoSuchOriginRequestPolicy) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting CloudFront Origin Request Policy (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandCloudFrontOriginRequestPolicyConfig(d *schema.ResourceData) *cloudfront.OriginRequestPolicyConfig {
+	apiObject := &cloudfront.OriginRequestPolicyConfig{
+		CookiesConfig:      &cloudfront.OriginRequestPolicyCookiesConfig{},
+		HeadersConfig:      &cloudfront.OriginRequestPolicyHeadersConfig{},
+		Name:               aws.String(d.Get("name").(string)),
+		QueryStringsConfig: &cloudfront.OriginRequestPolicyQueryStringsConfig{},
+	}
+
+	if v, ok := d.GetOk("comment"); ok {
+		apiObject.Comment = aws.String(v.(string))
+	}
+
+	if v, ok := d.Get("cookies_config").([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		m := v[0].(map[string]interface{})
+		apiObject.CookiesConfig.CookieBehavior = aws.String(m["cookie_behavior"].(string))
+		if items := expandCloudFrontPolicyItems(m["cookies"].([]interface{})); items != nil {
+			apiObject.CookiesConfig.Cookies = &cloudfront.CookieNames{
+				Items:    items,
+				Quantity: aws.Int64(int64(len(items))),
+			}
+		}
+	}
+
+	if v, ok := d.Get("headers_config").([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		m := v[0].(map[string]interface{})
+		apiObject.HeadersConfig.HeaderBehavior = aws.String(m["header_behavior"].(string))
+		if items := expandCloudFrontPolicyItems(m["headers"].([]interface{})); items != nil {
+			apiObject.HeadersConfig.Headers = &cloudfront.Headers{
+				Items:    items,
+				Quantity: aws.Int64(int64(len(items))),
+			}
+		}
+	}
+
+	if v, ok := d.Get("query_strings_config").([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		m := v[0].(map[string]interface{})
+		apiObject.QueryStringsConfig.QueryStringBehavior = aws.String(m["query_string_behavior"].(string))
+		if items := expandCloudFrontPolicyItems(m["query_strings"].([]interface{})); items != nil {
+			apiObject.QueryStringsConfig.QueryStrings = &cloudfront.QueryStringNames{
+				Items:    items,
+				Quantity: aws.Int64(int64(len(items))),
+			}
+		}
+	}
+
+	return apiObject
+}
+
+// setCloudFrontOriginRequestPolicyConfig sets the origin request policy configuration
+// attributes shared by the aws_cloudfront_origin_request_policy resource and data source.
+func setCloudFrontOriginRequestPolicyConfig(d *schema.ResourceData, apiObject *cloudfront.OriginRequestPolicyConfig) error {
+	d.Set("comment", apiObject.Comment)
+	d.Set("name", apiObject.Name)
+
+	if v := apiObject.CookiesConfig; v != nil {
+		m := map[string]interface{}{
+			"cookie_behavior": aws.StringValue(v.CookieBehavior),
+		}
+		if v.Cookies != nil {
+			m["cookies"] = flattenCloudFrontPolicyItems(v.Cookies.Items)
+		}
+		if err := d.Set("cookies_config", []interface{}{m}); err != nil {
+			return fmt.Errorf("error setting cookies_config: %w", err)
+		}
+	}
+
+	if v := apiObject.HeadersConfig; v != nil {
+		m := map[string]interface{}{
+			"header_behavior": aws.StringValue(v.HeaderBehavior),
+		}
+		if v.Headers != nil {
+			m["headers"] = flattenCloudFrontPolicyItems(v.Headers.Items)
+		}
+		if err := d.Set("headers_config", []interface{}{m}); err != nil {
+			return fmt.Errorf("error setting headers_config: %w", err)
+		}
+	}
+
+	if v := apiObject.QueryStringsConfig; v != nil {
+		m := map[string]interface{}{
+			"query_string_behavior": aws.StringValue(v.QueryStringBehavior),
+		}
+		if v.QueryStrings != nil {
+			m["query_strings"] = flattenCloudFrontPolicyItems(v.QueryStrings.Items)
+		}
+		if err := d.Set("query_strings_config", []interface{}{m}); err != nil {
+			return fmt.Errorf("error setting query_strings_config: %w", err)
+		}
+	}
+
+	return nil
+}
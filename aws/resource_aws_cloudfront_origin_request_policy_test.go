@@ -0,0 +1,215 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfawsresource"
+)
+
+func TestAccAWSCloudFrontOriginRequestPolicy_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudfront_origin_request_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCloudFront(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontOriginRequestPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFrontOriginRequestPolicyConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFrontOriginRequestPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "comment", ""),
+					resource.TestCheckResourceAttr(resourceName, "cookies_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cookies_config.0.cookie_behavior", "none"),
+					resource.TestCheckResourceAttr(resourceName, "cookies_config.0.cookies.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "etag"),
+					resource.TestCheckResourceAttr(resourceName, "headers_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "headers_config.0.header_behavior", "none"),
+					resource.TestCheckResourceAttr(resourceName, "headers_config.0.headers.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "query_strings_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "query_strings_config.0.query_string_behavior", "none"),
+					resource.TestCheckResourceAttr(resourceName, "query_strings_config.0.query_strings.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFrontOriginRequestPolicy_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudfront_origin_request_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCloudFront(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontOriginRequestPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFrontOriginRequestPolicyConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFrontOriginRequestPolicyExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsCloudFrontOriginRequestPolicy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFrontOriginRequestPolicy_Items(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudfront_origin_request_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCloudFront(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontOriginRequestPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFrontOriginRequestPolicyConfigItems(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFrontOriginRequestPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "comment", "test comment"),
+					resource.TestCheckResourceAttr(resourceName, "cookies_config.0.cookie_behavior", "whitelist"),
+					resource.TestCheckResourceAttr(resourceName, "cookies_config.0.cookies.0.items.#", "2"),
+					tfawsresource.TestCheckTypeSetElemAttr(resourceName, "cookies_config.0.cookies.0.items.*", "test1"),
+					tfawsresource.TestCheckTypeSetElemAttr(resourceName, "cookies_config.0.cookies.0.items.*", "test2"),
+					resource.TestCheckResourceAttr(resourceName, "headers_config.0.header_behavior", "allViewerAndWhitelistCloudFront"),
+					resource.TestCheckResourceAttr(resourceName, "headers_config.0.headers.0.items.#", "1"),
+					tfawsresource.TestCheckTypeSetElemAttr(resourceName, "headers_config.0.headers.0.items.*", "CloudFront-Is-Desktop-Viewer"),
+					resource.TestCheckResourceAttr(resourceName, "query_strings_config.0.query_string_behavior", "whitelist"),
+					resource.TestCheckResourceAttr(resourceName, "query_strings_config.0.query_strings.0.items.#", "1"),
+					tfawsresource.TestCheckTypeSetElemAttr(resourceName, "query_strings_config.0.query_strings.0.items.*", "test"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSCloudFrontOriginRequestPolicyConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFrontOriginRequestPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "comment", ""),
+					resource.TestCheckResourceAttr(resourceName, "cookies_config.0.cookie_behavior", "none"),
+					resource.TestCheckResourceAttr(resourceName, "cookies_config.0.cookies.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCloudFrontOriginRequestPolicyDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cloudfrontconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloudfront_origin_request_policy" {
+			continue
+		}
+
+		_, err := conn.GetOriginRequestPolicy(&cloudfront.GetOriginRequestPolicyInput{
+			Id: aws.String(rs.Primary.ID),
+		})
+
+		if tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeNoSuchOriginRequestPolicy) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("CloudFront Origin Request Policy %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckCloudFrontOriginRequestPolicyExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CloudFront Origin Request Policy ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cloudfrontconn
+
+		_, err := conn.GetOriginRequestPolicy(&cloudfront.GetOriginRequestPolicyInput{
+			Id: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccAWSCloudFrontOriginRequestPolicyConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudfront_origin_request_policy" "test" {
+  name = %[1]q
+
+  cookies_config {
+    cookie_behavior = "none"
+  }
+
+  headers_config {
+    header_behavior = "none"
+  }
+
+  query_strings_config {
+    query_string_behavior = "none"
+  }
+}
+`, rName)
+}
+
+func testAccAWSCloudFrontOriginRequestPolicyConfigItems(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudfront_origin_request_policy" "test" {
+  name    = %[1]q
+  comment = "test comment"
+
+  cookies_config {
+    cookie_behavior = "whitelist"
+
+    cookies {
+      items = ["test2", "test1"]
+    }
+  }
+
+  headers_config {
+    header_behavior = "allViewerAndWhitelistCloudFront"
+
+    headers {
+      items = ["CloudFront-Is-Desktop-Viewer"]
+    }
+  }
+
+  query_strings_config {
+    query_string_behavior = "whitelist"
+
+    query_strings {
+      items = ["test"]
+    }
+  }
+}
+`, rName)
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfevents "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudwatchevents"
 )
 
 func resourceAwsCloudWatchEventPermission() *schema.Resource {
@@ -68,6 +69,13 @@ func resourceAwsCloudWatchEventPermission() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validateCloudWatchEventPermissionStatementID,
 			},
+			"event_bus_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCloudWatchEventBusName,
+				Default:      tfevents.DefaultEventBusName,
+			},
 		},
 	}
 }
@@ -75,6 +83,7 @@ func resourceAwsCloudWatchEventPermission() *schema.Resource {
 func resourceAwsCloudWatchEventPermissionCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cloudwatcheventsconn
 
+	eventBusName := d.Get("event_bus_name").(string)
 	statementID := d.Get("statement_id").(string)
 
 	input := events.PutPermissionInput{
@@ -84,13 +93,17 @@ func resourceAwsCloudWatchEventPermissionCreate(d *schema.ResourceData, meta int
 		StatementId: aws.String(statementID),
 	}
 
+	if eventBusName != "" {
+		input.EventBusName = aws.String(eventBusName)
+	}
+
 	log.Printf("[DEBUG] Creating CloudWatch Events permission: %s", input)
 	_, err := conn.PutPermission(&input)
 	if err != nil {
 		return fmt.Errorf("Creating CloudWatch Events permission failed: %s", err.Error())
 	}
 
-	d.SetId(statementID)
+	d.SetId(tfevents.PermissionCreateID(eventBusName, statementID))
 
 	return resourceAwsCloudWatchEventPermissionRead(d, meta)
 }
@@ -98,19 +111,28 @@ func resourceAwsCloudWatchEventPermissionCreate(d *schema.ResourceData, meta int
 // See also: https://docs.aws.amazon.com/AmazonCloudWatchEvents/latest/APIReference/API_DescribeEventBus.html
 func resourceAwsCloudWatchEventPermissionRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cloudwatcheventsconn
+
+	eventBusName, statementID, err := tfevents.PermissionParseID(d.Id())
+	if err != nil {
+		return fmt.Errorf("error reading CloudWatch Events permission (%s): %w", d.Id(), err)
+	}
+
 	input := events.DescribeEventBusInput{}
+	if eventBusName != "" {
+		input.Name = aws.String(eventBusName)
+	}
 	var output *events.DescribeEventBusOutput
 	var policyStatement *CloudWatchEventPermissionPolicyStatement
 
 	// Especially with concurrent PutPermission calls there can be a slight delay
-	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
 		log.Printf("[DEBUG] Reading CloudWatch Events bus: %s", input)
 		output, err := conn.DescribeEventBus(&input)
 		if err != nil {
 			return resource.NonRetryableError(fmt.Errorf("Reading CloudWatch Events permission '%s' failed: %s", d.Id(), err.Error()))
 		}
 
-		policyStatement, err = getPolicyStatement(output, d.Id())
+		policyStatement, err = getPolicyStatement(output, statementID)
 		if err != nil {
 			return resource.RetryableError(err)
 		}
@@ -120,7 +142,7 @@ func resourceAwsCloudWatchEventPermissionRead(d *schema.ResourceData, meta inter
 	if isResourceTimeoutError(err) {
 		output, err = conn.DescribeEventBus(&input)
 		if output != nil {
-			policyStatement, err = getPolicyStatement(output, d.Id())
+			policyStatement, err = getPolicyStatement(output, statementID)
 		}
 	}
 
@@ -152,6 +174,7 @@ func resourceAwsCloudWatchEventPermissionRead(d *schema.ResourceData, meta inter
 		d.Set("principal", policyARN.AccountID)
 	}
 	d.Set("statement_id", policyStatement.Sid)
+	d.Set("event_bus_name", eventBusName)
 
 	return nil
 }
@@ -178,6 +201,8 @@ func getPolicyStatement(output *events.DescribeEventBusOutput, statementID strin
 func resourceAwsCloudWatchEventPermissionUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cloudwatcheventsconn
 
+	eventBusName := d.Get("event_bus_name").(string)
+
 	input := events.PutPermissionInput{
 		Action:      aws.String(d.Get("action").(string)),
 		Condition:   expandCloudWatchEventsCondition(d.Get("condition").([]interface{})),
@@ -185,6 +210,10 @@ func resourceAwsCloudWatchEventPermissionUpdate(d *schema.ResourceData, meta int
 		StatementId: aws.String(d.Get("statement_id").(string)),
 	}
 
+	if eventBusName != "" {
+		input.EventBusName = aws.String(eventBusName)
+	}
+
 	log.Printf("[DEBUG] Update CloudWatch Events permission: %s", input)
 	_, err := conn.PutPermission(&input)
 	if isAWSErr(err, events.ErrCodeResourceNotFoundException, "") {
@@ -201,12 +230,21 @@ func resourceAwsCloudWatchEventPermissionUpdate(d *schema.ResourceData, meta int
 
 func resourceAwsCloudWatchEventPermissionDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cloudwatcheventsconn
+
+	eventBusName, statementID, err := tfevents.PermissionParseID(d.Id())
+	if err != nil {
+		return fmt.Errorf("error deleting CloudWatch Events permission (%s): %w", d.Id(), err)
+	}
+
 	input := events.RemovePermissionInput{
-		StatementId: aws.String(d.Id()),
+		StatementId: aws.String(statementID),
+	}
+	if eventBusName != "" {
+		input.EventBusName = aws.String(eventBusName)
 	}
 
 	log.Printf("[DEBUG] Delete CloudWatch Events permission: %s", input)
-	_, err := conn.RemovePermission(&input)
+	_, err = conn.RemovePermission(&input)
 	if isAWSErr(err, events.ErrCodeResourceNotFoundException, "") {
 		return nil
 	}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfevents "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudwatchevents"
 )
 
 func init() {
@@ -115,6 +116,36 @@ func TestAccAWSCloudWatchEventRule_basic(t *testing.T) {
 	})
 }
 
+func TestAccAWSCloudWatchEventRule_EventBusName(t *testing.T) {
+	var rule events.DescribeRuleOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	busName := acctest.RandomWithPrefix("tf-acc-test-bus")
+	resourceName := "aws_cloudwatch_event_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchEventRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudWatchEventRuleConfigEventBusName(rName, busName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventRuleExists(resourceName, &rule),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "events", fmt.Sprintf("rule/%s/%s", busName, rName)),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "event_bus_name", "aws_cloudwatch_event_bus.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("%s/%s", busName, rName)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccAWSCloudWatchEventRule_role(t *testing.T) {
 	var rule events.DescribeRuleOutput
 	rName := acctest.RandomWithPrefix("tf-acc-test")
@@ -329,8 +360,15 @@ func testAccCheckCloudWatchEventRuleExists(n string, rule *events.DescribeRuleOu
 		}
 
 		conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn
+
+		eventBusName, ruleName, err := tfevents.RuleParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
 		params := events.DescribeRuleInput{
-			Name: aws.String(rs.Primary.ID),
+			EventBusName: aws.String(eventBusName),
+			Name:         aws.String(ruleName),
 		}
 		resp, err := conn.DescribeRule(&params)
 		if err != nil {
@@ -354,8 +392,15 @@ func testAccCheckCloudWatchEventRuleEnabled(n string, desired string) resource.T
 		}
 
 		conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn
+
+		eventBusName, ruleName, err := tfevents.RuleParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
 		params := events.DescribeRuleInput{
-			Name: aws.String(rs.Primary.ID),
+			EventBusName: aws.String(eventBusName),
+			Name:         aws.String(ruleName),
 		}
 		resp, err := conn.DescribeRule(&params)
 
@@ -378,8 +423,14 @@ func testAccCheckAWSCloudWatchEventRuleDestroy(s *terraform.State) error {
 			continue
 		}
 
+		eventBusName, ruleName, err := tfevents.RuleParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
 		params := events.DescribeRuleInput{
-			Name: aws.String(rs.Primary.ID),
+			EventBusName: aws.String(eventBusName),
+			Name:         aws.String(ruleName),
 		}
 
 		resp, err := conn.DescribeRule(&params)
@@ -402,6 +453,25 @@ resource "aws_cloudwatch_event_rule" "test" {
 `, name)
 }
 
+func testAccAWSCloudWatchEventRuleConfigEventBusName(name, eventBusName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_event_bus" "test" {
+  name = %[2]q
+}
+
+resource "aws_cloudwatch_event_rule" "test" {
+  name           = %[1]q
+  event_bus_name = aws_cloudwatch_event_bus.test.name
+
+  event_pattern = <<PATTERN
+{
+  "source": ["aws.ec2"]
+}
+PATTERN
+}
+`, name, eventBusName)
+}
+
 func testAccAWSCloudWatchEventRuleConfigPattern(name, pattern string) string {
 	return fmt.Sprintf(`
 resource "aws_cloudwatch_event_rule" "test" {
@@ -5,7 +5,6 @@ import (
 	"log"
 	"math"
 	"regexp"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	events "github.com/aws/aws-sdk-go/service/cloudwatchevents"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfevents "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudwatchevents"
 )
 
 func resourceAwsCloudWatchEventTarget() *schema.Resource {
@@ -28,6 +28,14 @@ func resourceAwsCloudWatchEventTarget() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"event_bus_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCloudWatchEventBusName,
+				Default:      tfevents.DefaultEventBusName,
+			},
+
 			"rule": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -255,8 +263,7 @@ func resourceAwsCloudWatchEventTargetCreate(d *schema.ResourceData, meta interfa
 			out.FailedEntries)
 	}
 
-	id := rule + "-" + targetId
-	d.SetId(id)
+	d.SetId(tfevents.TargetCreateID(d.Get("event_bus_name").(string), rule, targetId))
 
 	log.Printf("[INFO] CloudWatch Event Target %q created", d.Id())
 
@@ -266,9 +273,12 @@ func resourceAwsCloudWatchEventTargetCreate(d *schema.ResourceData, meta interfa
 func resourceAwsCloudWatchEventTargetRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cloudwatcheventsconn
 
+	busName := d.Get("event_bus_name").(string)
+
 	t, err := findEventTargetById(
 		d.Get("target_id").(string),
 		d.Get("rule").(string),
+		busName,
 		nil, conn)
 	if err != nil {
 		if regexp.MustCompile(" not found$").MatchString(err.Error()) {
@@ -298,6 +308,7 @@ func resourceAwsCloudWatchEventTargetRead(d *schema.ResourceData, meta interface
 
 	d.Set("arn", t.Arn)
 	d.Set("target_id", t.Id)
+	d.Set("event_bus_name", busName)
 	d.Set("input", t.Input)
 	d.Set("input_path", t.InputPath)
 	d.Set("role_arn", t.RoleArn)
@@ -341,12 +352,15 @@ func resourceAwsCloudWatchEventTargetRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func findEventTargetById(id, rule string, nextToken *string, conn *events.CloudWatchEvents) (*events.Target, error) {
+func findEventTargetById(id, rule, busName string, nextToken *string, conn *events.CloudWatchEvents) (*events.Target, error) {
 	input := events.ListTargetsByRuleInput{
 		Rule:      aws.String(rule),
 		NextToken: nextToken,
 		Limit:     aws.Int64(100), // Set limit to allowed maximum to prevent API throttling
 	}
+	if busName != "" {
+		input.EventBusName = aws.String(busName)
+	}
 	log.Printf("[DEBUG] Reading CloudWatch Event Target: %s", input)
 	out, err := conn.ListTargetsByRule(&input)
 	if err != nil {
@@ -360,7 +374,7 @@ func findEventTargetById(id, rule string, nextToken *string, conn *events.CloudW
 	}
 
 	if out.NextToken != nil {
-		return findEventTargetById(id, rule, nextToken, conn)
+		return findEventTargetById(id, rule, busName, out.NextToken, conn)
 	}
 
 	return nil, fmt.Errorf("CloudWatch Event Target %q (%q) not found", id, rule)
@@ -388,6 +402,10 @@ func resourceAwsCloudWatchEventTargetDelete(d *schema.ResourceData, meta interfa
 		Rule: aws.String(d.Get("rule").(string)),
 	}
 
+	if v, ok := d.GetOk("event_bus_name"); ok {
+		input.EventBusName = aws.String(v.(string))
+	}
+
 	output, err := conn.RemoveTargets(input)
 
 	if err != nil {
@@ -446,6 +464,10 @@ func buildPutTargetInputStruct(d *schema.ResourceData) *events.PutTargetsInput {
 		Targets: []*events.Target{e},
 	}
 
+	if v, ok := d.GetOk("event_bus_name"); ok {
+		input.EventBusName = aws.String(v.(string))
+	}
+
 	return &input
 }
 
@@ -665,17 +687,15 @@ func flattenAwsCloudWatchInputTransformer(inputTransformer *events.InputTransfor
 }
 
 func resourceAwsCloudWatchEventTargetImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	idParts := strings.SplitN(d.Id(), "/", 2)
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		return nil, fmt.Errorf("unexpected format (%q), expected <rule-name>/<target-id>", d.Id())
+	busName, ruleName, targetName, err := tfevents.TargetParseImportID(d.Id())
+	if err != nil {
+		return []*schema.ResourceData{}, err
 	}
 
-	ruleName := idParts[0]
-	targetName := idParts[1]
-
 	d.Set("target_id", targetName)
 	d.Set("rule", ruleName)
-	d.SetId(ruleName + "-" + targetName)
+	d.Set("event_bus_name", busName)
+	d.SetId(tfevents.TargetCreateID(busName, ruleName, targetName))
 
 	return []*schema.ResourceData{d}, nil
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfevents "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudwatchevents"
 )
 
 func init() {
@@ -133,6 +134,40 @@ func TestAccAWSCloudWatchEventTarget_basic(t *testing.T) {
 	})
 }
 
+func TestAccAWSCloudWatchEventTarget_EventBusName(t *testing.T) {
+	resourceName := "aws_cloudwatch_event_target.test"
+
+	var target events.Target
+	ruleName := acctest.RandomWithPrefix("tf-acc-test-rule")
+	busName := acctest.RandomWithPrefix("tf-acc-test-bus")
+	snsTopicName := acctest.RandomWithPrefix("tf-acc-test-sns")
+	targetID := acctest.RandomWithPrefix("tf-acc-test-target")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchEventTargetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudWatchEventTargetConfigEventBusName(ruleName, busName, snsTopicName, targetID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventTargetExists(resourceName, &target),
+					resource.TestCheckResourceAttr(resourceName, "rule", ruleName),
+					resource.TestCheckResourceAttr(resourceName, "event_bus_name", busName),
+					resource.TestCheckResourceAttr(resourceName, "target_id", targetID),
+					resource.TestCheckResourceAttrPair(resourceName, "arn", "aws_sns_topic.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSCloudWatchEventTargetImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccAWSCloudWatchEventTarget_missingTargetId(t *testing.T) {
 	resourceName := "aws_cloudwatch_event_target.test"
 
@@ -383,7 +418,7 @@ func testAccCheckCloudWatchEventTargetExists(n string, rule *events.Target) reso
 
 		conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn
 		t, err := findEventTargetById(rs.Primary.Attributes["target_id"],
-			rs.Primary.Attributes["rule"], nil, conn)
+			rs.Primary.Attributes["rule"], rs.Primary.Attributes["event_bus_name"], nil, conn)
 		if err != nil {
 			return fmt.Errorf("Event Target not found: %s", err)
 		}
@@ -403,7 +438,7 @@ func testAccCheckAWSCloudWatchEventTargetDestroy(s *terraform.State) error {
 		}
 
 		t, err := findEventTargetById(rs.Primary.Attributes["target_id"],
-			rs.Primary.Attributes["rule"], nil, conn)
+			rs.Primary.Attributes["rule"], rs.Primary.Attributes["event_bus_name"], nil, conn)
 		if err == nil {
 			return fmt.Errorf("CloudWatch Event Target %q still exists: %s",
 				rs.Primary.ID, t)
@@ -420,6 +455,10 @@ func testAccAWSCloudWatchEventTargetImportStateIdFunc(resourceName string) resou
 			return "", fmt.Errorf("Not found: %s", resourceName)
 		}
 
+		if busName := rs.Primary.Attributes["event_bus_name"]; busName != "" && busName != tfevents.DefaultEventBusName {
+			return fmt.Sprintf("%s/%s/%s", busName, rs.Primary.Attributes["rule"], rs.Primary.Attributes["target_id"]), nil
+		}
+
 		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["rule"], rs.Primary.Attributes["target_id"]), nil
 	}
 }
@@ -443,6 +482,36 @@ resource "aws_sns_topic" "test" {
 `, ruleName, targetID, snsTopicName)
 }
 
+func testAccAWSCloudWatchEventTargetConfigEventBusName(ruleName, eventBusName, snsTopicName, targetID string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_event_bus" "test" {
+  name = %[2]q
+}
+
+resource "aws_cloudwatch_event_rule" "test" {
+  name           = %[1]q
+  event_bus_name = aws_cloudwatch_event_bus.test.name
+
+  event_pattern = <<PATTERN
+{
+  "source": ["aws.ec2"]
+}
+PATTERN
+}
+
+resource "aws_cloudwatch_event_target" "test" {
+  rule           = aws_cloudwatch_event_rule.test.name
+  event_bus_name = aws_cloudwatch_event_rule.test.event_bus_name
+  target_id      = %[4]q
+  arn            = aws_sns_topic.test.arn
+}
+
+resource "aws_sns_topic" "test" {
+  name = %[3]q
+}
+`, ruleName, eventBusName, snsTopicName, targetID)
+}
+
 func testAccAWSCloudWatchEventTargetConfigMissingTargetId(ruleName, snsTopicName string) string {
 	return fmt.Sprintf(`
 resource "aws_cloudwatch_event_rule" "test" {
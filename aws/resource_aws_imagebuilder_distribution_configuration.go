@@ -0,0 +1,408 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/imagebuilder"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func resourceAwsImageBuilderDistributionConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsImageBuilderDistributionConfigurationCreate,
+		Read:   resourceAwsImageBuilderDistributionConfigurationRead,
+		Update: resourceAwsImageBuilderDistributionConfigurationUpdate,
+		Delete: resourceAwsImageBuilderDistributionConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_updated": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"distribution": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ami_distribution_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ami_tags": tagsSchema(),
+									"description": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 1024),
+									},
+									"kms_key_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 1024),
+									},
+									"launch_permission": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"user_groups": {
+													Type:     schema.TypeSet,
+													Optional: true,
+													Elem: &schema.Schema{
+														Type:         schema.TypeString,
+														ValidateFunc: validation.StringLenBetween(1, 1024),
+													},
+												},
+												"user_ids": {
+													Type:     schema.TypeSet,
+													Optional: true,
+													Elem: &schema.Schema{
+														Type:         schema.TypeString,
+														ValidateFunc: validateAwsAccountId,
+													},
+												},
+											},
+										},
+									},
+									"name": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(0, 127),
+									},
+									"target_account_ids": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validateAwsAccountId,
+										},
+									},
+								},
+							},
+						},
+						"license_configuration_arns": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateArn,
+							},
+						},
+						"region": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 126),
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+		},
+
+		CustomizeDiff: SetTagsDiff,
+	}
+}
+
+func resourceAwsImageBuilderDistributionConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).imagebuilderconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &imagebuilder.CreateDistributionConfigurationInput{
+		Distributions: expandImageBuilderDistributions(d.Get("distribution").(*schema.Set).List()),
+		Name:          aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().ImagebuilderTags()
+	}
+
+	log.Printf("[DEBUG] Creating Image Builder Distribution Configuration: %s", input)
+	output, err := conn.CreateDistributionConfiguration(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Image Builder Distribution Configuration (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.DistributionConfigurationArn))
+
+	return resourceAwsImageBuilderDistributionConfigurationRead(d, meta)
+}
+
+func resourceAwsImageBuilderDistributionConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).imagebuilderconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	output, err := conn.GetDistributionConfiguration(&imagebuilder.GetDistributionConfigurationInput{
+		DistributionConfigurationArn: aws.String(d.Id()),
+	})
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, imagebuilder.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Image Builder Distribution Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Image Builder Distribution Configuration (%s): %w", d.Id(), err)
+	}
+
+	if output == nil || output.DistributionConfiguration == nil {
+		return fmt.Errorf("error reading Image Builder Distribution Configuration (%s): empty response", d.Id())
+	}
+
+	distributionConfiguration := output.DistributionConfiguration
+
+	d.Set("arn", distributionConfiguration.Arn)
+	d.Set("date_created", distributionConfiguration.DateCreated)
+	d.Set("date_updated", distributionConfiguration.DateUpdated)
+	d.Set("description", distributionConfiguration.Description)
+	if err := d.Set("distribution", flattenImageBuilderDistributions(distributionConfiguration.Distributions)); err != nil {
+		return fmt.Errorf("error setting distribution: %w", err)
+	}
+	d.Set("name", distributionConfiguration.Name)
+
+	tags := keyvaluetags.ImagebuilderKeyValueTags(distributionConfiguration.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsImageBuilderDistributionConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).imagebuilderconn
+
+	if d.HasChanges("description", "distribution") {
+		input := &imagebuilder.UpdateDistributionConfigurationInput{
+			DistributionConfigurationArn: aws.String(d.Id()),
+			Distributions:                expandImageBuilderDistributions(d.Get("distribution").(*schema.Set).List()),
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating Image Builder Distribution Configuration: %s", input)
+		_, err := conn.UpdateDistributionConfiguration(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Image Builder Distribution Configuration (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.ImagebuilderUpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating Image Builder Distribution Configuration (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsImageBuilderDistributionConfigurationRead(d, meta)
+}
+
+func resourceAwsImageBuilderDistributionConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).imagebuilderconn
+
+	log.Printf("[DEBUG] Deleting Image Builder Distribution Configuration (%s)", d.Id())
+	_, err := conn.DeleteDistributionConfiguration(&imagebuilder.DeleteDistributionConfigurationInput{
+		DistributionConfigurationArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, imagebuilder.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Image Builder Distribution Configuration (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandImageBuilderDistributions(tfList []interface{}) []*imagebuilder.Distribution {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*imagebuilder.Distribution
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &imagebuilder.Distribution{}
+
+		if v, ok := tfMap["ami_distribution_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.AmiDistributionConfiguration = expandImageBuilderAmiDistributionConfiguration(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["license_configuration_arns"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.LicenseConfigurationArns = expandStringSet(v)
+		}
+
+		if v, ok := tfMap["region"].(string); ok && v != "" {
+			apiObject.Region = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandImageBuilderAmiDistributionConfiguration(tfMap map[string]interface{}) *imagebuilder.AmiDistributionConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &imagebuilder.AmiDistributionConfiguration{}
+
+	if v, ok := tfMap["ami_tags"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.AmiTags = stringMapToPointers(v)
+	}
+
+	if v, ok := tfMap["description"].(string); ok && v != "" {
+		apiObject.Description = aws.String(v)
+	}
+
+	if v, ok := tfMap["kms_key_id"].(string); ok && v != "" {
+		apiObject.KmsKeyId = aws.String(v)
+	}
+
+	if v, ok := tfMap["launch_permission"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.LaunchPermission = expandImageBuilderLaunchPermissionConfiguration(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	if v, ok := tfMap["target_account_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.TargetAccountIds = expandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func expandImageBuilderLaunchPermissionConfiguration(tfMap map[string]interface{}) *imagebuilder.LaunchPermissionConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &imagebuilder.LaunchPermissionConfiguration{}
+
+	if v, ok := tfMap["user_groups"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.UserGroups = expandStringSet(v)
+	}
+
+	if v, ok := tfMap["user_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.UserIds = expandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func flattenImageBuilderDistributions(apiObjects []*imagebuilder.Distribution) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"license_configuration_arns": aws.StringValueSlice(apiObject.LicenseConfigurationArns),
+			"region":                     aws.StringValue(apiObject.Region),
+		}
+
+		if v := apiObject.AmiDistributionConfiguration; v != nil {
+			tfMap["ami_distribution_configuration"] = []interface{}{flattenImageBuilderAmiDistributionConfiguration(v)}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenImageBuilderAmiDistributionConfiguration(apiObject *imagebuilder.AmiDistributionConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"ami_tags":           aws.StringValueMap(apiObject.AmiTags),
+		"description":        aws.StringValue(apiObject.Description),
+		"kms_key_id":         aws.StringValue(apiObject.KmsKeyId),
+		"name":               aws.StringValue(apiObject.Name),
+		"target_account_ids": aws.StringValueSlice(apiObject.TargetAccountIds),
+	}
+
+	if v := apiObject.LaunchPermission; v != nil {
+		tfMap["launch_permission"] = []interface{}{flattenImageBuilderLaunchPermissionConfiguration(v)}
+	}
+
+	return tfMap
+}
+
+func flattenImageBuilderLaunchPermissionConfiguration(apiObject *imagebuilder.LaunchPermissionConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"user_groups": aws.StringValueSlice(apiObject.UserGroups),
+		"user_ids":    aws.StringValueSlice(apiObject.UserIds),
+	}
+}
@@ -0,0 +1,380 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/imagebuilder"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func resourceAwsImageBuilderImagePipeline() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsImageBuilderImagePipelineCreate,
+		Read:   resourceAwsImageBuilderImagePipelineRead,
+		Update: resourceAwsImageBuilderImagePipelineUpdate,
+		Delete: resourceAwsImageBuilderImagePipelineDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_last_run": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_next_run": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_updated": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"distribution_configuration_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
+			"enhanced_image_metadata_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"image_recipe_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+			"image_tests_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"image_tests_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"timeout_minutes": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      720,
+							ValidateFunc: validation.IntBetween(60, 1440),
+						},
+					},
+				},
+			},
+			"infrastructure_configuration_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 126),
+			},
+			"platform": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"schedule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"pipeline_execution_start_condition": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      imagebuilder.PipelineExecutionStartConditionExpressionMatchOnly,
+							ValidateFunc: validation.StringInSlice(imagebuilder.PipelineExecutionStartCondition_Values(), false),
+						},
+						"schedule_expression": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+					},
+				},
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      imagebuilder.PipelineStatusEnabled,
+				ValidateFunc: validation.StringInSlice(imagebuilder.PipelineStatus_Values(), false),
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+		},
+
+		CustomizeDiff: SetTagsDiff,
+	}
+}
+
+func resourceAwsImageBuilderImagePipelineCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).imagebuilderconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &imagebuilder.CreateImagePipelineInput{
+		EnhancedImageMetadataEnabled:   aws.Bool(d.Get("enhanced_image_metadata_enabled").(bool)),
+		ImageRecipeArn:                 aws.String(d.Get("image_recipe_arn").(string)),
+		InfrastructureConfigurationArn: aws.String(d.Get("infrastructure_configuration_arn").(string)),
+		Name:                           aws.String(name),
+		Status:                         aws.String(d.Get("status").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("distribution_configuration_arn"); ok {
+		input.DistributionConfigurationArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("image_tests_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ImageTestsConfiguration = expandImageBuilderImageTestsConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("schedule"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Schedule = expandImageBuilderSchedule(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().ImagebuilderTags()
+	}
+
+	log.Printf("[DEBUG] Creating Image Builder Image Pipeline: %s", input)
+	output, err := conn.CreateImagePipeline(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Image Builder Image Pipeline (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ImagePipelineArn))
+
+	return resourceAwsImageBuilderImagePipelineRead(d, meta)
+}
+
+func resourceAwsImageBuilderImagePipelineRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).imagebuilderconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	output, err := conn.GetImagePipeline(&imagebuilder.GetImagePipelineInput{
+		ImagePipelineArn: aws.String(d.Id()),
+	})
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, imagebuilder.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Image Builder Image Pipeline (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Image Builder Image Pipeline (%s): %w", d.Id(), err)
+	}
+
+	if output == nil || output.ImagePipeline == nil {
+		return fmt.Errorf("error reading Image Builder Image Pipeline (%s): empty response", d.Id())
+	}
+
+	imagePipeline := output.ImagePipeline
+
+	d.Set("arn", imagePipeline.Arn)
+	d.Set("date_created", imagePipeline.DateCreated)
+	d.Set("date_last_run", imagePipeline.DateLastRun)
+	d.Set("date_next_run", imagePipeline.DateNextRun)
+	d.Set("date_updated", imagePipeline.DateUpdated)
+	d.Set("description", imagePipeline.Description)
+	d.Set("distribution_configuration_arn", imagePipeline.DistributionConfigurationArn)
+	d.Set("enhanced_image_metadata_enabled", imagePipeline.EnhancedImageMetadataEnabled)
+	d.Set("image_recipe_arn", imagePipeline.ImageRecipeArn)
+	if imagePipeline.ImageTestsConfiguration != nil {
+		if err := d.Set("image_tests_configuration", []interface{}{flattenImageBuilderImageTestsConfiguration(imagePipeline.ImageTestsConfiguration)}); err != nil {
+			return fmt.Errorf("error setting image_tests_configuration: %w", err)
+		}
+	} else {
+		d.Set("image_tests_configuration", nil)
+	}
+	d.Set("infrastructure_configuration_arn", imagePipeline.InfrastructureConfigurationArn)
+	d.Set("name", imagePipeline.Name)
+	d.Set("platform", imagePipeline.Platform)
+	if imagePipeline.Schedule != nil {
+		if err := d.Set("schedule", []interface{}{flattenImageBuilderSchedule(imagePipeline.Schedule)}); err != nil {
+			return fmt.Errorf("error setting schedule: %w", err)
+		}
+	} else {
+		d.Set("schedule", nil)
+	}
+	d.Set("status", imagePipeline.Status)
+
+	tags := keyvaluetags.ImagebuilderKeyValueTags(imagePipeline.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsImageBuilderImagePipelineUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).imagebuilderconn
+
+	if d.HasChanges(
+		"description",
+		"distribution_configuration_arn",
+		"enhanced_image_metadata_enabled",
+		"image_recipe_arn",
+		"image_tests_configuration",
+		"infrastructure_configuration_arn",
+		"schedule",
+		"status",
+	) {
+		input := &imagebuilder.UpdateImagePipelineInput{
+			EnhancedImageMetadataEnabled:   aws.Bool(d.Get("enhanced_image_metadata_enabled").(bool)),
+			ImagePipelineArn:               aws.String(d.Id()),
+			ImageRecipeArn:                 aws.String(d.Get("image_recipe_arn").(string)),
+			InfrastructureConfigurationArn: aws.String(d.Get("infrastructure_configuration_arn").(string)),
+			Status:                         aws.String(d.Get("status").(string)),
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("distribution_configuration_arn"); ok {
+			input.DistributionConfigurationArn = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("image_tests_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.ImageTestsConfiguration = expandImageBuilderImageTestsConfiguration(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("schedule"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.Schedule = expandImageBuilderSchedule(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating Image Builder Image Pipeline: %s", input)
+		_, err := conn.UpdateImagePipeline(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Image Builder Image Pipeline (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.ImagebuilderUpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating Image Builder Image Pipeline (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsImageBuilderImagePipelineRead(d, meta)
+}
+
+func resourceAwsImageBuilderImagePipelineDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).imagebuilderconn
+
+	log.Printf("[DEBUG] Deleting Image Builder Image Pipeline (%s)", d.Id())
+	_, err := conn.DeleteImagePipeline(&imagebuilder.DeleteImagePipelineInput{
+		ImagePipelineArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, imagebuilder.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Image Builder Image Pipeline (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandImageBuilderImageTestsConfiguration(tfMap map[string]interface{}) *imagebuilder.ImageTestsConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &imagebuilder.ImageTestsConfiguration{}
+
+	if v, ok := tfMap["image_tests_enabled"].(bool); ok {
+		apiObject.ImageTestsEnabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["timeout_minutes"].(int); ok && v != 0 {
+		apiObject.TimeoutMinutes = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandImageBuilderSchedule(tfMap map[string]interface{}) *imagebuilder.Schedule {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &imagebuilder.Schedule{}
+
+	if v, ok := tfMap["pipeline_execution_start_condition"].(string); ok && v != "" {
+		apiObject.PipelineExecutionStartCondition = aws.String(v)
+	}
+
+	if v, ok := tfMap["schedule_expression"].(string); ok && v != "" {
+		apiObject.ScheduleExpression = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenImageBuilderImageTestsConfiguration(apiObject *imagebuilder.ImageTestsConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"image_tests_enabled": aws.BoolValue(apiObject.ImageTestsEnabled),
+		"timeout_minutes":     aws.Int64Value(apiObject.TimeoutMinutes),
+	}
+}
+
+func flattenImageBuilderSchedule(apiObject *imagebuilder.Schedule) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"pipeline_execution_start_condition": aws.StringValue(apiObject.PipelineExecutionStartCondition),
+		"schedule_expression":                aws.StringValue(apiObject.ScheduleExpression),
+	}
+}
@@ -0,0 +1,424 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/imagebuilder"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	iamwaiter "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iam/waiter"
+)
+
+func resourceAwsImageBuilderInfrastructureConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsImageBuilderInfrastructureConfigurationCreate,
+		Read:   resourceAwsImageBuilderInfrastructureConfigurationRead,
+		Update: resourceAwsImageBuilderInfrastructureConfigurationUpdate,
+		Delete: resourceAwsImageBuilderInfrastructureConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_updated": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"instance_profile_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"instance_types": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"key_pair": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"logging": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"s3_logs": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"s3_bucket_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 1024),
+									},
+									"s3_key_prefix": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 1024),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 126),
+			},
+			"resource_tags": tagsSchema(),
+			"security_group_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"sns_topic_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+			"terminate_instance_on_failure": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+
+		CustomizeDiff: SetTagsDiff,
+	}
+}
+
+func resourceAwsImageBuilderInfrastructureConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).imagebuilderconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &imagebuilder.CreateInfrastructureConfigurationInput{
+		InstanceProfileName:        aws.String(d.Get("instance_profile_name").(string)),
+		Name:                       aws.String(name),
+		TerminateInstanceOnFailure: aws.Bool(d.Get("terminate_instance_on_failure").(bool)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("instance_types"); ok && v.(*schema.Set).Len() > 0 {
+		input.InstanceTypes = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("key_pair"); ok {
+		input.KeyPair = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("logging"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Logging = expandImageBuilderLogging(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("resource_tags"); ok && len(v.(map[string]interface{})) > 0 {
+		input.ResourceTags = stringMapToPointers(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("security_group_ids"); ok && v.(*schema.Set).Len() > 0 {
+		input.SecurityGroupIds = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("sns_topic_arn"); ok {
+		input.SnsTopicArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("subnet_id"); ok {
+		input.SubnetId = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().ImagebuilderTags()
+	}
+
+	log.Printf("[DEBUG] Creating Image Builder Infrastructure Configuration: %s", input)
+	var output *imagebuilder.CreateInfrastructureConfigurationOutput
+	err := resource.Retry(iamwaiter.PropagationTimeout, func() *resource.RetryError {
+		var err error
+
+		output, err = conn.CreateInfrastructureConfiguration(input)
+
+		// Newly created IAM instance profiles may not yet be visible to Image Builder.
+		if tfawserr.ErrMessageContains(err, imagebuilder.ErrCodeInvalidParameterValueException, "instance profile does not exist") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if isResourceTimeoutError(err) {
+		output, err = conn.CreateInfrastructureConfiguration(input)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error creating Image Builder Infrastructure Configuration (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.InfrastructureConfigurationArn))
+
+	return resourceAwsImageBuilderInfrastructureConfigurationRead(d, meta)
+}
+
+func resourceAwsImageBuilderInfrastructureConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).imagebuilderconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	output, err := conn.GetInfrastructureConfiguration(&imagebuilder.GetInfrastructureConfigurationInput{
+		InfrastructureConfigurationArn: aws.String(d.Id()),
+	})
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, imagebuilder.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Image Builder Infrastructure Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Image Builder Infrastructure Configuration (%s): %w", d.Id(), err)
+	}
+
+	if output == nil || output.InfrastructureConfiguration == nil {
+		return fmt.Errorf("error reading Image Builder Infrastructure Configuration (%s): empty response", d.Id())
+	}
+
+	infrastructureConfiguration := output.InfrastructureConfiguration
+
+	d.Set("arn", infrastructureConfiguration.Arn)
+	d.Set("date_created", infrastructureConfiguration.DateCreated)
+	d.Set("date_updated", infrastructureConfiguration.DateUpdated)
+	d.Set("description", infrastructureConfiguration.Description)
+	d.Set("instance_profile_name", infrastructureConfiguration.InstanceProfileName)
+	d.Set("instance_types", aws.StringValueSlice(infrastructureConfiguration.InstanceTypes))
+	d.Set("key_pair", infrastructureConfiguration.KeyPair)
+	if infrastructureConfiguration.Logging != nil {
+		if err := d.Set("logging", []interface{}{flattenImageBuilderLogging(infrastructureConfiguration.Logging)}); err != nil {
+			return fmt.Errorf("error setting logging: %w", err)
+		}
+	} else {
+		d.Set("logging", nil)
+	}
+	d.Set("name", infrastructureConfiguration.Name)
+	d.Set("resource_tags", aws.StringValueMap(infrastructureConfiguration.ResourceTags))
+	d.Set("security_group_ids", aws.StringValueSlice(infrastructureConfiguration.SecurityGroupIds))
+	d.Set("sns_topic_arn", infrastructureConfiguration.SnsTopicArn)
+	d.Set("subnet_id", infrastructureConfiguration.SubnetId)
+	d.Set("terminate_instance_on_failure", infrastructureConfiguration.TerminateInstanceOnFailure)
+
+	tags := keyvaluetags.ImagebuilderKeyValueTags(infrastructureConfiguration.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsImageBuilderInfrastructureConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).imagebuilderconn
+
+	if d.HasChanges(
+		"description",
+		"instance_profile_name",
+		"instance_types",
+		"key_pair",
+		"logging",
+		"resource_tags",
+		"security_group_ids",
+		"sns_topic_arn",
+		"subnet_id",
+		"terminate_instance_on_failure",
+	) {
+		input := &imagebuilder.UpdateInfrastructureConfigurationInput{
+			InfrastructureConfigurationArn: aws.String(d.Id()),
+			InstanceProfileName:            aws.String(d.Get("instance_profile_name").(string)),
+			TerminateInstanceOnFailure:     aws.Bool(d.Get("terminate_instance_on_failure").(bool)),
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("instance_types"); ok && v.(*schema.Set).Len() > 0 {
+			input.InstanceTypes = expandStringSet(v.(*schema.Set))
+		}
+
+		if v, ok := d.GetOk("key_pair"); ok {
+			input.KeyPair = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("logging"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.Logging = expandImageBuilderLogging(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("resource_tags"); ok && len(v.(map[string]interface{})) > 0 {
+			input.ResourceTags = stringMapToPointers(v.(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("security_group_ids"); ok && v.(*schema.Set).Len() > 0 {
+			input.SecurityGroupIds = expandStringSet(v.(*schema.Set))
+		}
+
+		if v, ok := d.GetOk("sns_topic_arn"); ok {
+			input.SnsTopicArn = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("subnet_id"); ok {
+			input.SubnetId = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating Image Builder Infrastructure Configuration: %s", input)
+		err := resource.Retry(iamwaiter.PropagationTimeout, func() *resource.RetryError {
+			_, err := conn.UpdateInfrastructureConfiguration(input)
+
+			if tfawserr.ErrMessageContains(err, imagebuilder.ErrCodeInvalidParameterValueException, "instance profile does not exist") {
+				return resource.RetryableError(err)
+			}
+
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+
+			return nil
+		})
+
+		if isResourceTimeoutError(err) {
+			_, err = conn.UpdateInfrastructureConfiguration(input)
+		}
+
+		if err != nil {
+			return fmt.Errorf("error updating Image Builder Infrastructure Configuration (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.ImagebuilderUpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating Image Builder Infrastructure Configuration (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsImageBuilderInfrastructureConfigurationRead(d, meta)
+}
+
+func resourceAwsImageBuilderInfrastructureConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).imagebuilderconn
+
+	log.Printf("[DEBUG] Deleting Image Builder Infrastructure Configuration (%s)", d.Id())
+	_, err := conn.DeleteInfrastructureConfiguration(&imagebuilder.DeleteInfrastructureConfigurationInput{
+		InfrastructureConfigurationArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, imagebuilder.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Image Builder Infrastructure Configuration (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandImageBuilderLogging(tfMap map[string]interface{}) *imagebuilder.Logging {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &imagebuilder.Logging{}
+
+	if v, ok := tfMap["s3_logs"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.S3Logs = expandImageBuilderS3Logs(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandImageBuilderS3Logs(tfMap map[string]interface{}) *imagebuilder.S3Logs {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &imagebuilder.S3Logs{}
+
+	if v, ok := tfMap["s3_bucket_name"].(string); ok && v != "" {
+		apiObject.S3BucketName = aws.String(v)
+	}
+
+	if v, ok := tfMap["s3_key_prefix"].(string); ok && v != "" {
+		apiObject.S3KeyPrefix = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenImageBuilderLogging(apiObject *imagebuilder.Logging) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.S3Logs; v != nil {
+		tfMap["s3_logs"] = []interface{}{flattenImageBuilderS3Logs(v)}
+	}
+
+	return tfMap
+}
+
+func flattenImageBuilderS3Logs(apiObject *imagebuilder.S3Logs) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"s3_bucket_name": aws.StringValue(apiObject.S3BucketName),
+		"s3_key_prefix":  aws.StringValue(apiObject.S3KeyPrefix),
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfawsresource"
 )
 
 func init() {
@@ -475,6 +476,7 @@ func TestAccAWSIoTTopicRule_iot_analytics(t *testing.T) {
 				Config: testAccAWSIoTTopicRule_iot_analytics(rName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAWSIoTTopicRuleExists("aws_iot_topic_rule.rule"),
+					tfawsresource.TestCheckTypeSetElemAttrPair("aws_iot_topic_rule.rule", "iot_analytics.*.channel_name", "aws_iotanalytics_channel.test", "name"),
 				),
 			},
 		},
@@ -493,6 +495,7 @@ func TestAccAWSIoTTopicRule_iot_events(t *testing.T) {
 				Config: testAccAWSIoTTopicRule_iot_events(rName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAWSIoTTopicRuleExists("aws_iot_topic_rule.rule"),
+					tfawsresource.TestCheckTypeSetElemAttrPair("aws_iot_topic_rule.rule", "iot_events.*.input_name", "aws_iotevents_input.test", "name"),
 				),
 			},
 		},
@@ -980,6 +983,10 @@ resource "aws_iot_topic_rule" "rule" {
 
 func testAccAWSIoTTopicRule_iot_analytics(rName string) string {
 	return fmt.Sprintf(testAccAWSIoTTopicRuleRole+`
+resource "aws_iotanalytics_channel" "test" {
+  name = "test_channel_%[1]s"
+}
+
 resource "aws_iot_topic_rule" "rule" {
   name        = "test_rule_%[1]s"
   description = "Example rule"
@@ -988,7 +995,7 @@ resource "aws_iot_topic_rule" "rule" {
   sql_version = "2015-10-08"
 
   iot_analytics {
-    channel_name = "fakedata"
+    channel_name = aws_iotanalytics_channel.test.name
     role_arn     = aws_iam_role.iot_role.arn
   }
 }
@@ -997,6 +1004,16 @@ resource "aws_iot_topic_rule" "rule" {
 
 func testAccAWSIoTTopicRule_iot_events(rName string) string {
 	return fmt.Sprintf(testAccAWSIoTTopicRuleRole+`
+resource "aws_iotevents_input" "test" {
+  name = "test_input_%[1]s"
+
+  definition {
+    attribute {
+      json_path = "temperature"
+    }
+  }
+}
+
 resource "aws_iot_topic_rule" "rule" {
   name        = "test_rule_%[1]s"
   description = "Example rule"
@@ -1005,7 +1022,7 @@ resource "aws_iot_topic_rule" "rule" {
   sql_version = "2015-10-08"
 
   iot_events {
-    input_name = "fake_input_name"
+    input_name = aws_iotevents_input.test.name
     role_arn   = aws_iam_role.iot_role.arn
     message_id = "fake_message_id"
   }
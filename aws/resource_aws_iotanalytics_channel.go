@@ -0,0 +1,350 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iotanalytics/finder"
+)
+
+func resourceAwsIotAnalyticsChannel() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIotAnalyticsChannelCreate,
+		Read:   resourceAwsIotAnalyticsChannelRead,
+		Update: resourceAwsIotAnalyticsChannelUpdate,
+		Delete: resourceAwsIotAnalyticsChannelDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"customer_managed_s3": iotAnalyticsCustomerManagedS3Schema(),
+
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIotAnalyticsName,
+			},
+
+			"retention_period": iotAnalyticsRetentionPeriodSchema(),
+
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+		},
+	}
+}
+
+var validateIotAnalyticsName = validation.All(
+	validation.StringLenBetween(1, 128),
+	validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_]+$`), "must contain only alphanumeric characters and underscores"),
+)
+
+func iotAnalyticsCustomerManagedS3Schema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"bucket": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(3, 255),
+				},
+
+				"key_prefix": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ValidateFunc: validation.All(
+						validation.StringLenBetween(1, 255),
+						validation.StringMatch(regexp.MustCompile(`/$`), "must end with a forward slash"),
+					),
+				},
+
+				"role_arn": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validateArn,
+				},
+			},
+		},
+	}
+}
+
+func iotAnalyticsRetentionPeriodSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"number_of_days": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+
+				"unlimited": {
+					Type:     schema.TypeBool,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsIotAnalyticsChannelCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+
+	input := &iotanalytics.CreateChannelInput{
+		ChannelName:    aws.String(name),
+		ChannelStorage: expandIotAnalyticsChannelStorage(d.Get("customer_managed_s3").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("retention_period"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.RetentionPeriod = expandIotAnalyticsRetentionPeriod(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().IotanalyticsTags()
+	}
+
+	log.Printf("[DEBUG] Creating IoT Analytics Channel: %s", input)
+	_, err := conn.CreateChannel(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating IoT Analytics Channel (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsIotAnalyticsChannelRead(d, meta)
+}
+
+func resourceAwsIotAnalyticsChannelRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	channel, err := finder.ChannelByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, iotanalytics.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] IoT Analytics Channel (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading IoT Analytics Channel (%s): %w", d.Id(), err)
+	}
+
+	if channel == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading IoT Analytics Channel (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] IoT Analytics Channel (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	arn := aws.StringValue(channel.Arn)
+	d.Set("arn", arn)
+
+	if channel.Storage != nil && channel.Storage.CustomerManagedS3 != nil {
+		if err := d.Set("customer_managed_s3", []interface{}{flattenIotAnalyticsCustomerManagedChannelS3Storage(channel.Storage.CustomerManagedS3)}); err != nil {
+			return fmt.Errorf("error setting customer_managed_s3: %w", err)
+		}
+	} else {
+		d.Set("customer_managed_s3", nil)
+	}
+
+	d.Set("name", channel.Name)
+
+	if channel.RetentionPeriod != nil {
+		if err := d.Set("retention_period", []interface{}{flattenIotAnalyticsRetentionPeriod(channel.RetentionPeriod)}); err != nil {
+			return fmt.Errorf("error setting retention_period: %w", err)
+		}
+	} else {
+		d.Set("retention_period", nil)
+	}
+
+	tags, err := keyvaluetags.IotanalyticsListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for IoT Analytics Channel (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsIotAnalyticsChannelUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+
+	if d.HasChanges("customer_managed_s3", "retention_period") {
+		input := &iotanalytics.UpdateChannelInput{
+			ChannelName:    aws.String(d.Id()),
+			ChannelStorage: expandIotAnalyticsChannelStorage(d.Get("customer_managed_s3").([]interface{})),
+		}
+
+		if v, ok := d.GetOk("retention_period"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.RetentionPeriod = expandIotAnalyticsRetentionPeriod(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating IoT Analytics Channel: %s", input)
+		_, err := conn.UpdateChannel(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating IoT Analytics Channel (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.IotanalyticsUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating IoT Analytics Channel (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsIotAnalyticsChannelRead(d, meta)
+}
+
+func resourceAwsIotAnalyticsChannelDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+
+	log.Printf("[DEBUG] Deleting IoT Analytics Channel (%s)", d.Id())
+	_, err := conn.DeleteChannel(&iotanalytics.DeleteChannelInput{
+		ChannelName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotanalytics.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting IoT Analytics Channel (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// expandIotAnalyticsChannelStorage returns service-managed storage when no
+// customer-managed S3 configuration is present so that removing the
+// configuration block reverts the channel to the default storage.
+func expandIotAnalyticsChannelStorage(tfList []interface{}) *iotanalytics.ChannelStorage {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return &iotanalytics.ChannelStorage{
+			ServiceManagedS3: &iotanalytics.ServiceManagedChannelS3Storage{},
+		}
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &iotanalytics.CustomerManagedChannelS3Storage{}
+
+	if v, ok := tfMap["bucket"].(string); ok && v != "" {
+		apiObject.Bucket = aws.String(v)
+	}
+
+	if v, ok := tfMap["key_prefix"].(string); ok && v != "" {
+		apiObject.KeyPrefix = aws.String(v)
+	}
+
+	if v, ok := tfMap["role_arn"].(string); ok && v != "" {
+		apiObject.RoleArn = aws.String(v)
+	}
+
+	return &iotanalytics.ChannelStorage{
+		CustomerManagedS3: apiObject,
+	}
+}
+
+func expandIotAnalyticsRetentionPeriod(tfMap map[string]interface{}) *iotanalytics.RetentionPeriod {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotanalytics.RetentionPeriod{}
+
+	if v, ok := tfMap["number_of_days"].(int); ok && v != 0 {
+		apiObject.NumberOfDays = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["unlimited"].(bool); ok && v {
+		apiObject.Unlimited = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func flattenIotAnalyticsCustomerManagedChannelS3Storage(apiObject *iotanalytics.CustomerManagedChannelS3Storage) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Bucket; v != nil {
+		tfMap["bucket"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.KeyPrefix; v != nil {
+		tfMap["key_prefix"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.RoleArn; v != nil {
+		tfMap["role_arn"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenIotAnalyticsRetentionPeriod(apiObject *iotanalytics.RetentionPeriod) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.NumberOfDays; v != nil {
+		tfMap["number_of_days"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.Unlimited; v != nil {
+		tfMap["unlimited"] = aws.BoolValue(v)
+	}
+
+	return tfMap
+}
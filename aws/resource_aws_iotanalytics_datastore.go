@@ -0,0 +1,249 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iotanalytics/finder"
+)
+
+func resourceAwsIotAnalyticsDatastore() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIotAnalyticsDatastoreCreate,
+		Read:   resourceAwsIotAnalyticsDatastoreRead,
+		Update: resourceAwsIotAnalyticsDatastoreUpdate,
+		Delete: resourceAwsIotAnalyticsDatastoreDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"customer_managed_s3": iotAnalyticsCustomerManagedS3Schema(),
+
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIotAnalyticsName,
+			},
+
+			"retention_period": iotAnalyticsRetentionPeriodSchema(),
+
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+		},
+	}
+}
+
+func resourceAwsIotAnalyticsDatastoreCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+
+	input := &iotanalytics.CreateDatastoreInput{
+		DatastoreName:    aws.String(name),
+		DatastoreStorage: expandIotAnalyticsDatastoreStorage(d.Get("customer_managed_s3").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("retention_period"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.RetentionPeriod = expandIotAnalyticsRetentionPeriod(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().IotanalyticsTags()
+	}
+
+	log.Printf("[DEBUG] Creating IoT Analytics Datastore: %s", input)
+	_, err := conn.CreateDatastore(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating IoT Analytics Datastore (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsIotAnalyticsDatastoreRead(d, meta)
+}
+
+func resourceAwsIotAnalyticsDatastoreRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	datastore, err := finder.DatastoreByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, iotanalytics.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] IoT Analytics Datastore (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading IoT Analytics Datastore (%s): %w", d.Id(), err)
+	}
+
+	if datastore == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading IoT Analytics Datastore (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] IoT Analytics Datastore (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	arn := aws.StringValue(datastore.Arn)
+	d.Set("arn", arn)
+
+	if datastore.Storage != nil && datastore.Storage.CustomerManagedS3 != nil {
+		if err := d.Set("customer_managed_s3", []interface{}{flattenIotAnalyticsCustomerManagedDatastoreS3Storage(datastore.Storage.CustomerManagedS3)}); err != nil {
+			return fmt.Errorf("error setting customer_managed_s3: %w", err)
+		}
+	} else {
+		d.Set("customer_managed_s3", nil)
+	}
+
+	d.Set("name", datastore.Name)
+
+	if datastore.RetentionPeriod != nil {
+		if err := d.Set("retention_period", []interface{}{flattenIotAnalyticsRetentionPeriod(datastore.RetentionPeriod)}); err != nil {
+			return fmt.Errorf("error setting retention_period: %w", err)
+		}
+	} else {
+		d.Set("retention_period", nil)
+	}
+
+	tags, err := keyvaluetags.IotanalyticsListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for IoT Analytics Datastore (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsIotAnalyticsDatastoreUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+
+	if d.HasChanges("customer_managed_s3", "retention_period") {
+		input := &iotanalytics.UpdateDatastoreInput{
+			DatastoreName:    aws.String(d.Id()),
+			DatastoreStorage: expandIotAnalyticsDatastoreStorage(d.Get("customer_managed_s3").([]interface{})),
+		}
+
+		if v, ok := d.GetOk("retention_period"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.RetentionPeriod = expandIotAnalyticsRetentionPeriod(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating IoT Analytics Datastore: %s", input)
+		_, err := conn.UpdateDatastore(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating IoT Analytics Datastore (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.IotanalyticsUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating IoT Analytics Datastore (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsIotAnalyticsDatastoreRead(d, meta)
+}
+
+func resourceAwsIotAnalyticsDatastoreDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+
+	log.Printf("[DEBUG] Deleting IoT Analytics Datastore (%s)", d.Id())
+	_, err := conn.DeleteDatastore(&iotanalytics.DeleteDatastoreInput{
+		DatastoreName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotanalytics.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting IoT Analytics Datastore (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandIotAnalyticsDatastoreStorage(tfList []interface{}) *iotanalytics.DatastoreStorage {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return &iotanalytics.DatastoreStorage{
+			ServiceManagedS3: &iotanalytics.ServiceManagedDatastoreS3Storage{},
+		}
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &iotanalytics.CustomerManagedDatastoreS3Storage{}
+
+	if v, ok := tfMap["bucket"].(string); ok && v != "" {
+		apiObject.Bucket = aws.String(v)
+	}
+
+	if v, ok := tfMap["key_prefix"].(string); ok && v != "" {
+		apiObject.KeyPrefix = aws.String(v)
+	}
+
+	if v, ok := tfMap["role_arn"].(string); ok && v != "" {
+		apiObject.RoleArn = aws.String(v)
+	}
+
+	return &iotanalytics.DatastoreStorage{
+		CustomerManagedS3: apiObject,
+	}
+}
+
+func flattenIotAnalyticsCustomerManagedDatastoreS3Storage(apiObject *iotanalytics.CustomerManagedDatastoreS3Storage) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Bucket; v != nil {
+		tfMap["bucket"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.KeyPrefix; v != nil {
+		tfMap["key_prefix"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.RoleArn; v != nil {
+		tfMap["role_arn"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
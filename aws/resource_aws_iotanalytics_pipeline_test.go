@@ -0,0 +1,424 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iotanalytics/finder"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_iotanalytics_pipeline", &resource.Sweeper{
+		Name: "aws_iotanalytics_pipeline",
+		F:    testSweepIotAnalyticsPipelines,
+	})
+}
+
+func testSweepIotAnalyticsPipelines(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.(*AWSClient).iotanalyticsconn
+	input := &iotanalytics.ListPipelinesInput{}
+	var sweeperErrs *multierror.Error
+
+	for {
+		output, err := conn.ListPipelines(input)
+
+		if testSweepSkipSweepError(err) {
+			log.Printf("[WARN] Skipping IoT Analytics Pipeline sweep for %s: %s", region, err)
+			return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		}
+
+		if err != nil {
+			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving IoT Analytics Pipelines: %w", err))
+			return sweeperErrs.ErrorOrNil()
+		}
+
+		for _, pipelineSummary := range output.PipelineSummaries {
+			name := aws.StringValue(pipelineSummary.PipelineName)
+			log.Printf("[INFO] Deleting IoT Analytics Pipeline: %s", name)
+
+			r := resourceAwsIotAnalyticsPipeline()
+			d := r.Data(nil)
+			d.SetId(name)
+
+			if err := r.Delete(d, client); err != nil {
+				log.Printf("[ERROR] %s", err)
+				sweeperErrs = multierror.Append(sweeperErrs, err)
+				continue
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSIotAnalyticsPipeline_basic(t *testing.T) {
+	var v iotanalytics.Pipeline
+	rName := fmt.Sprintf("tf_acc_test_%d", acctest.RandInt())
+	resourceName := "aws_iotanalytics_pipeline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(iotanalytics.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotAnalyticsPipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotAnalyticsPipelineConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsPipelineExists(resourceName, &v),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "iotanalytics", regexp.MustCompile(`pipeline/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "activity.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "activity.0.channel.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "activity.0.channel.0.channel_name", "aws_iotanalytics_channel.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "activity.0.channel.0.name", "channel_activity"),
+					resource.TestCheckResourceAttr(resourceName, "activity.1.datastore.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "activity.1.datastore.0.datastore_name", "aws_iotanalytics_datastore.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "activity.1.datastore.0.name", "datastore_activity"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSIotAnalyticsPipeline_disappears(t *testing.T) {
+	var v iotanalytics.Pipeline
+	rName := fmt.Sprintf("tf_acc_test_%d", acctest.RandInt())
+	resourceName := "aws_iotanalytics_pipeline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(iotanalytics.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotAnalyticsPipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotAnalyticsPipelineConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsPipelineExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsIotAnalyticsPipeline(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSIotAnalyticsPipeline_Tags(t *testing.T) {
+	var v iotanalytics.Pipeline
+	rName := fmt.Sprintf("tf_acc_test_%d", acctest.RandInt())
+	resourceName := "aws_iotanalytics_pipeline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(iotanalytics.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotAnalyticsPipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotAnalyticsPipelineConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsPipelineExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSIotAnalyticsPipelineConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsPipelineExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSIotAnalyticsPipelineConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsPipelineExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSIotAnalyticsPipeline_update(t *testing.T) {
+	var v iotanalytics.Pipeline
+	rName := fmt.Sprintf("tf_acc_test_%d", acctest.RandInt())
+	resourceName := "aws_iotanalytics_pipeline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(iotanalytics.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotAnalyticsPipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotAnalyticsPipelineConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsPipelineExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "activity.#", "2"),
+				),
+			},
+			{
+				Config: testAccAWSIotAnalyticsPipelineConfigActivities(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsPipelineExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "activity.#", "5"),
+					resource.TestCheckResourceAttr(resourceName, "activity.0.channel.0.name", "channel_activity"),
+					resource.TestCheckResourceAttr(resourceName, "activity.1.filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "activity.1.filter.0.filter", "temperature > 20"),
+					resource.TestCheckResourceAttr(resourceName, "activity.1.filter.0.name", "filter_activity"),
+					resource.TestCheckResourceAttr(resourceName, "activity.2.add_attributes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "activity.2.add_attributes.0.attributes.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "activity.2.add_attributes.0.attributes.temperature", "temperature_copy"),
+					resource.TestCheckResourceAttr(resourceName, "activity.2.add_attributes.0.name", "add_attributes_activity"),
+					resource.TestCheckResourceAttr(resourceName, "activity.3.math.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "activity.3.math.0.attribute", "temperature_fahrenheit"),
+					resource.TestCheckResourceAttr(resourceName, "activity.3.math.0.math", "temperature * 9 / 5 + 32"),
+					resource.TestCheckResourceAttr(resourceName, "activity.3.math.0.name", "math_activity"),
+					resource.TestCheckResourceAttr(resourceName, "activity.4.datastore.0.name", "datastore_activity"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSIotAnalyticsPipelineConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsPipelineExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "activity.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "activity.0.channel.0.name", "channel_activity"),
+					resource.TestCheckResourceAttr(resourceName, "activity.1.datastore.0.name", "datastore_activity"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSIotAnalyticsPipelineExists(resourceName string, v *iotanalytics.Pipeline) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT Analytics Pipeline ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).iotanalyticsconn
+
+		output, err := finder.PipelineByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("IoT Analytics Pipeline (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSIotAnalyticsPipelineDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).iotanalyticsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iotanalytics_pipeline" {
+			continue
+		}
+
+		output, err := finder.PipelineByName(conn, rs.Primary.ID)
+
+		if tfawserr.ErrCodeEquals(err, iotanalytics.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil {
+			return fmt.Errorf("IoT Analytics Pipeline (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSIotAnalyticsPipelineBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iotanalytics_channel" "test" {
+  name = %[1]q
+}
+
+resource "aws_iotanalytics_datastore" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSIotAnalyticsPipelineConfig(rName string) string {
+	return composeConfig(
+		testAccAWSIotAnalyticsPipelineBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_iotanalytics_pipeline" "test" {
+  name = %[1]q
+
+  activity {
+    channel {
+      name         = "channel_activity"
+      channel_name = aws_iotanalytics_channel.test.name
+    }
+  }
+
+  activity {
+    datastore {
+      name           = "datastore_activity"
+      datastore_name = aws_iotanalytics_datastore.test.name
+    }
+  }
+}
+`, rName))
+}
+
+func testAccAWSIotAnalyticsPipelineConfigActivities(rName string) string {
+	return composeConfig(
+		testAccAWSIotAnalyticsPipelineBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_iotanalytics_pipeline" "test" {
+  name = %[1]q
+
+  activity {
+    channel {
+      name         = "channel_activity"
+      channel_name = aws_iotanalytics_channel.test.name
+    }
+  }
+
+  activity {
+    filter {
+      name   = "filter_activity"
+      filter = "temperature > 20"
+    }
+  }
+
+  activity {
+    add_attributes {
+      name = "add_attributes_activity"
+
+      attributes = {
+        temperature = "temperature_copy"
+      }
+    }
+  }
+
+  activity {
+    math {
+      name      = "math_activity"
+      attribute = "temperature_fahrenheit"
+      math      = "temperature * 9 / 5 + 32"
+    }
+  }
+
+  activity {
+    datastore {
+      name           = "datastore_activity"
+      datastore_name = aws_iotanalytics_datastore.test.name
+    }
+  }
+}
+`, rName))
+}
+
+func testAccAWSIotAnalyticsPipelineConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return composeConfig(
+		testAccAWSIotAnalyticsPipelineBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_iotanalytics_pipeline" "test" {
+  name = %[1]q
+
+  activity {
+    channel {
+      name         = "channel_activity"
+      channel_name = aws_iotanalytics_channel.test.name
+    }
+  }
+
+  activity {
+    datastore {
+      name           = "datastore_activity"
+      datastore_name = aws_iotanalytics_datastore.test.name
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccAWSIotAnalyticsPipelineConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(
+		testAccAWSIotAnalyticsPipelineBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_iotanalytics_pipeline" "test" {
+  name = %[1]q
+
+  activity {
+    channel {
+      name         = "channel_activity"
+      channel_name = aws_iotanalytics_channel.test.name
+    }
+  }
+
+  activity {
+    datastore {
+      name           = "datastore_activity"
+      datastore_name = aws_iotanalytics_datastore.test.name
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,1737 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iotevents/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iotevents/waiter"
+)
+
+func resourceAwsIotEventsDetectorModel() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIotEventsDetectorModelCreate,
+		Read:   resourceAwsIotEventsDetectorModelRead,
+		Update: resourceAwsIotEventsDetectorModelUpdate,
+		Delete: resourceAwsIotEventsDetectorModelDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"definition": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"initial_state_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 128),
+						},
+
+						"state": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"on_enter": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"event": iotEventsEventSchema(),
+											},
+										},
+									},
+
+									"on_exit": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"event": iotEventsEventSchema(),
+											},
+										},
+									},
+
+									"on_input": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"event": iotEventsEventSchema(),
+
+												"transition_event": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"action": iotEventsActionSchema(),
+
+															"condition": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(1, 512),
+															},
+
+															"event_name": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(1, 128),
+															},
+
+															"next_state": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(1, 128),
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+
+									"state_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 128),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 128),
+			},
+
+			"evaluation_method": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(iotevents.EvaluationMethod_Values(), false),
+			},
+
+			"key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexp.MustCompile("^((`[\\w\\- ]+`)|([\\w\\-]+))(\\.((`[\\w- ]+`)|([\\w\\-]+)))*$"), "must be a valid JSON path"),
+				),
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_-]+$`), "must contain only alphanumeric characters, hyphens and underscores"),
+				),
+			},
+
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func iotEventsEventSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"action": iotEventsActionSchema(),
+
+				"condition": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(0, 512),
+				},
+
+				"event_name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 128),
+				},
+			},
+		},
+	}
+}
+
+func iotEventsActionSchema() *schema.Schema {
+	timerNameSchema := &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringLenBetween(1, 128),
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"clear_timer": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"timer_name": timerNameSchema,
+						},
+					},
+				},
+
+				"dynamodb": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"hash_key_field": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+
+							"hash_key_type": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringInSlice([]string{"NUMBER", "STRING"}, false),
+							},
+
+							"hash_key_value": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+
+							"operation": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringInSlice([]string{"DELETE", "INSERT", "UPDATE"}, false),
+							},
+
+							"payload": iotEventsPayloadSchema(),
+
+							"payload_field": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+
+							"range_key_field": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+
+							"range_key_type": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringInSlice([]string{"NUMBER", "STRING"}, false),
+							},
+
+							"range_key_value": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+
+							"table_name": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+						},
+					},
+				},
+
+				"dynamodb_v2": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"payload": iotEventsPayloadSchema(),
+
+							"table_name": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+						},
+					},
+				},
+
+				"firehose": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"delivery_stream_name": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+
+							"payload": iotEventsPayloadSchema(),
+
+							"separator": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([\n\t])|(\r\n)|(,)$`), "must be one of '\\n', '\\t', '\\r\\n' or ','"),
+							},
+						},
+					},
+				},
+
+				"iot_events": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"input_name": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(1, 128),
+							},
+
+							"payload": iotEventsPayloadSchema(),
+						},
+					},
+				},
+
+				"iot_site_wise": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"asset_id": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+
+							"entry_id": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+
+							"property_alias": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+
+							"property_id": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+
+							"property_value": {
+								Type:     schema.TypeList,
+								Required: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"quality": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+
+										"timestamp": {
+											Type:     schema.TypeList,
+											Optional: true,
+											MaxItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"offset_in_nanos": {
+														Type:     schema.TypeString,
+														Optional: true,
+													},
+
+													"time_in_seconds": {
+														Type:     schema.TypeString,
+														Required: true,
+													},
+												},
+											},
+										},
+
+										"value": {
+											Type:     schema.TypeList,
+											Required: true,
+											MaxItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"boolean_value": {
+														Type:     schema.TypeString,
+														Optional: true,
+													},
+
+													"double_value": {
+														Type:     schema.TypeString,
+														Optional: true,
+													},
+
+													"integer_value": {
+														Type:     schema.TypeString,
+														Optional: true,
+													},
+
+													"string_value": {
+														Type:     schema.TypeString,
+														Optional: true,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+
+				"iot_topic_publish": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"mqtt_topic": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(1, 128),
+							},
+
+							"payload": iotEventsPayloadSchema(),
+						},
+					},
+				},
+
+				"lambda": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"function_arn": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validateArn,
+							},
+
+							"payload": iotEventsPayloadSchema(),
+						},
+					},
+				},
+
+				"reset_timer": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"timer_name": timerNameSchema,
+						},
+					},
+				},
+
+				"set_timer": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"duration_expression": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(1, 1024),
+							},
+
+							"timer_name": timerNameSchema,
+						},
+					},
+				},
+
+				"set_variable": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"value": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(1, 1024),
+							},
+
+							"variable_name": {
+								Type:     schema.TypeString,
+								Required: true,
+								ValidateFunc: validation.All(
+									validation.StringLenBetween(1, 128),
+									validation.StringMatch(regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]*$`), "must begin with a letter and contain only alphanumeric characters and underscores"),
+								),
+							},
+						},
+					},
+				},
+
+				"sns": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"payload": iotEventsPayloadSchema(),
+
+							"target_arn": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validateArn,
+							},
+						},
+					},
+				},
+
+				"sqs": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"payload": iotEventsPayloadSchema(),
+
+							"queue_url": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+
+							"use_base64": {
+								Type:     schema.TypeBool,
+								Optional: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func iotEventsPayloadSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"content_expression": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateFunc:     validation.StringLenBetween(1, 1024),
+					DiffSuppressFunc: suppressEquivalentIotEventsContentExpression,
+				},
+
+				"type": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(iotevents.PayloadType_Values(), false),
+				},
+			},
+		},
+	}
+}
+
+// suppressEquivalentIotEventsContentExpression suppresses differences between
+// payload content expressions that describe the same JSON document.
+// Expressions are commonly written as a single-quoted string literal wrapping
+// the JSON, e.g. '{"key": "${$input.example.value}"}'.
+func suppressEquivalentIotEventsContentExpression(k, old, new string, d *schema.ResourceData) bool {
+	return suppressEquivalentJsonDiffs(k, unquoteIotEventsContentExpression(old), unquoteIotEventsContentExpression(new), d)
+}
+
+func unquoteIotEventsContentExpression(expression string) string {
+	expression = strings.TrimSpace(expression)
+
+	if len(expression) >= 2 && strings.HasPrefix(expression, "'") && strings.HasSuffix(expression, "'") {
+		return expression[1 : len(expression)-1]
+	}
+
+	return expression
+}
+
+func resourceAwsIotEventsDetectorModelCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ioteventsconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+
+	input := &iotevents.CreateDetectorModelInput{
+		DetectorModelName: aws.String(name),
+		RoleArn:           aws.String(d.Get("role_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("definition"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.DetectorModelDefinition = expandIotEventsDetectorModelDefinition(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.DetectorModelDescription = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("evaluation_method"); ok {
+		input.EvaluationMethod = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("key"); ok {
+		input.Key = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().IoteventsTags()
+	}
+
+	log.Printf("[DEBUG] Creating IoT Events Detector Model: %s", input)
+	_, err := conn.CreateDetectorModel(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating IoT Events Detector Model (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waiter.DetectorModelActive(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for IoT Events Detector Model (%s) to become active: %w", d.Id(), err)
+	}
+
+	return resourceAwsIotEventsDetectorModelRead(d, meta)
+}
+
+func resourceAwsIotEventsDetectorModelRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ioteventsconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	detectorModel, err := finder.DetectorModelByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, iotevents.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] IoT Events Detector Model (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading IoT Events Detector Model (%s): %w", d.Id(), err)
+	}
+
+	if detectorModel == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading IoT Events Detector Model (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] IoT Events Detector Model (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	configuration := detectorModel.DetectorModelConfiguration
+	arn := aws.StringValue(configuration.DetectorModelArn)
+	d.Set("arn", arn)
+
+	if detectorModel.DetectorModelDefinition != nil {
+		if err := d.Set("definition", []interface{}{flattenIotEventsDetectorModelDefinition(detectorModel.DetectorModelDefinition)}); err != nil {
+			return fmt.Errorf("error setting definition: %w", err)
+		}
+	} else {
+		d.Set("definition", nil)
+	}
+
+	d.Set("description", configuration.DetectorModelDescription)
+	d.Set("evaluation_method", configuration.EvaluationMethod)
+	d.Set("key", configuration.Key)
+	d.Set("name", configuration.DetectorModelName)
+	d.Set("role_arn", configuration.RoleArn)
+	d.Set("version", configuration.DetectorModelVersion)
+
+	tags, err := keyvaluetags.IoteventsListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for IoT Events Detector Model (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsIotEventsDetectorModelUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ioteventsconn
+
+	if d.HasChanges("definition", "description", "evaluation_method", "role_arn") {
+		input := &iotevents.UpdateDetectorModelInput{
+			DetectorModelDescription: aws.String(d.Get("description").(string)),
+			DetectorModelName:        aws.String(d.Id()),
+			RoleArn:                  aws.String(d.Get("role_arn").(string)),
+		}
+
+		if v, ok := d.GetOk("definition"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.DetectorModelDefinition = expandIotEventsDetectorModelDefinition(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("evaluation_method"); ok {
+			input.EvaluationMethod = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating IoT Events Detector Model: %s", input)
+		_, err := conn.UpdateDetectorModel(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating IoT Events Detector Model (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waiter.DetectorModelActive(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for IoT Events Detector Model (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.IoteventsUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating IoT Events Detector Model (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsIotEventsDetectorModelRead(d, meta)
+}
+
+func resourceAwsIotEventsDetectorModelDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ioteventsconn
+
+	log.Printf("[DEBUG] Deleting IoT Events Detector Model (%s)", d.Id())
+	_, err := conn.DeleteDetectorModel(&iotevents.DeleteDetectorModelInput{
+		DetectorModelName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotevents.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting IoT Events Detector Model (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.DetectorModelDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for IoT Events Detector Model (%s) to delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandIotEventsDetectorModelDefinition(tfMap map[string]interface{}) *iotevents.DetectorModelDefinition {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotevents.DetectorModelDefinition{}
+
+	if v, ok := tfMap["initial_state_name"].(string); ok && v != "" {
+		apiObject.InitialStateName = aws.String(v)
+	}
+
+	if v, ok := tfMap["state"].([]interface{}); ok && len(v) > 0 {
+		apiObject.States = expandIotEventsStates(v)
+	}
+
+	return apiObject
+}
+
+func expandIotEventsState(tfMap map[string]interface{}) *iotevents.State {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotevents.State{}
+
+	if v, ok := tfMap["on_enter"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.OnEnter = &iotevents.OnEnterLifecycle{
+			Events: expandIotEventsEvents(v[0].(map[string]interface{})["event"].([]interface{})),
+		}
+	}
+
+	if v, ok := tfMap["on_exit"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.OnExit = &iotevents.OnExitLifecycle{
+			Events: expandIotEventsEvents(v[0].(map[string]interface{})["event"].([]interface{})),
+		}
+	}
+
+	if v, ok := tfMap["on_input"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.OnInput = expandIotEventsOnInputLifecycle(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["state_name"].(string); ok && v != "" {
+		apiObject.StateName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandIotEventsStates(tfList []interface{}) []*iotevents.State {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*iotevents.State
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandIotEventsState(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandIotEventsOnInputLifecycle(tfMap map[string]interface{}) *iotevents.OnInputLifecycle {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotevents.OnInputLifecycle{}
+
+	if v, ok := tfMap["event"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Events = expandIotEventsEvents(v)
+	}
+
+	if v, ok := tfMap["transition_event"].([]interface{}); ok && len(v) > 0 {
+		apiObject.TransitionEvents = expandIotEventsTransitionEvents(v)
+	}
+
+	return apiObject
+}
+
+func expandIotEventsEvent(tfMap map[string]interface{}) *iotevents.Event {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotevents.Event{}
+
+	if v, ok := tfMap["action"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Actions = expandIotEventsActions(v)
+	}
+
+	if v, ok := tfMap["condition"].(string); ok && v != "" {
+		apiObject.Condition = aws.String(v)
+	}
+
+	if v, ok := tfMap["event_name"].(string); ok && v != "" {
+		apiObject.EventName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandIotEventsEvents(tfList []interface{}) []*iotevents.Event {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*iotevents.Event
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandIotEventsEvent(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandIotEventsTransitionEvent(tfMap map[string]interface{}) *iotevents.TransitionEvent {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotevents.TransitionEvent{}
+
+	if v, ok := tfMap["action"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Actions = expandIotEventsActions(v)
+	}
+
+	if v, ok := tfMap["condition"].(string); ok && v != "" {
+		apiObject.Condition = aws.String(v)
+	}
+
+	if v, ok := tfMap["event_name"].(string); ok && v != "" {
+		apiObject.EventName = aws.String(v)
+	}
+
+	if v, ok := tfMap["next_state"].(string); ok && v != "" {
+		apiObject.NextState = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandIotEventsTransitionEvents(tfList []interface{}) []*iotevents.TransitionEvent {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*iotevents.TransitionEvent
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandIotEventsTransitionEvent(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandIotEventsAction(tfMap map[string]interface{}) *iotevents.ActionData {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotevents.ActionData{}
+
+	if v, ok := tfMap["clear_timer"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ClearTimer = &iotevents.ClearTimerAction{
+			TimerName: aws.String(v[0].(map[string]interface{})["timer_name"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["dynamodb"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.DynamoDB = expandIotEventsDynamoDBAction(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["dynamodb_v2"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.DynamoDBv2 = expandIotEventsDynamoDBv2Action(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["firehose"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Firehose = expandIotEventsFirehoseAction(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["iot_events"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.IotEvents = expandIotEventsIotEventsAction(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["iot_site_wise"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.IotSiteWise = expandIotEventsIotSiteWiseAction(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["iot_topic_publish"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.IotTopicPublish = expandIotEventsIotTopicPublishAction(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["lambda"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Lambda = expandIotEventsLambdaAction(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["reset_timer"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ResetTimer = &iotevents.ResetTimerAction{
+			TimerName: aws.String(v[0].(map[string]interface{})["timer_name"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["set_timer"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.SetTimer = &iotevents.SetTimerAction{
+			DurationExpression: aws.String(tfMap["duration_expression"].(string)),
+			TimerName:          aws.String(tfMap["timer_name"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["set_variable"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.SetVariable = &iotevents.SetVariableAction{
+			Value:        aws.String(tfMap["value"].(string)),
+			VariableName: aws.String(tfMap["variable_name"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["sns"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Sns = expandIotEventsSNSTopicPublishAction(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["sqs"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Sqs = expandIotEventsSqsAction(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandIotEventsActions(tfList []interface{}) []*iotevents.ActionData {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*iotevents.ActionData
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandIotEventsAction(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandIotEventsDynamoDBAction(tfMap map[string]interface{}) *iotevents.DynamoDBAction {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotevents.DynamoDBAction{}
+
+	if v, ok := tfMap["hash_key_field"].(string); ok && v != "" {
+		apiObject.HashKeyField = aws.String(v)
+	}
+
+	if v, ok := tfMap["hash_key_type"].(string); ok && v != "" {
+		apiObject.HashKeyType = aws.String(v)
+	}
+
+	if v, ok := tfMap["hash_key_value"].(string); ok && v != "" {
+		apiObject.HashKeyValue = aws.String(v)
+	}
+
+	if v, ok := tfMap["operation"].(string); ok && v != "" {
+		apiObject.Operation = aws.String(v)
+	}
+
+	if v, ok := tfMap["payload"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Payload = expandIotEventsPayload(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["payload_field"].(string); ok && v != "" {
+		apiObject.PayloadField = aws.String(v)
+	}
+
+	if v, ok := tfMap["range_key_field"].(string); ok && v != "" {
+		apiObject.RangeKeyField = aws.String(v)
+	}
+
+	if v, ok := tfMap["range_key_type"].(string); ok && v != "" {
+		apiObject.RangeKeyType = aws.String(v)
+	}
+
+	if v, ok := tfMap["range_key_value"].(string); ok && v != "" {
+		apiObject.RangeKeyValue = aws.String(v)
+	}
+
+	if v, ok := tfMap["table_name"].(string); ok && v != "" {
+		apiObject.TableName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandIotEventsDynamoDBv2Action(tfMap map[string]interface{}) *iotevents.DynamoDBv2Action {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotevents.DynamoDBv2Action{}
+
+	if v, ok := tfMap["payload"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Payload = expandIotEventsPayload(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["table_name"].(string); ok && v != "" {
+		apiObject.TableName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandIotEventsFirehoseAction(tfMap map[string]interface{}) *iotevents.FirehoseAction {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotevents.FirehoseAction{}
+
+	if v, ok := tfMap["delivery_stream_name"].(string); ok && v != "" {
+		apiObject.DeliveryStreamName = aws.String(v)
+	}
+
+	if v, ok := tfMap["payload"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Payload = expandIotEventsPayload(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["separator"].(string); ok && v != "" {
+		apiObject.Separator = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandIotEventsIotEventsAction(tfMap map[string]interface{}) *iotevents.Action {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotevents.Action{}
+
+	if v, ok := tfMap["input_name"].(string); ok && v != "" {
+		apiObject.InputName = aws.String(v)
+	}
+
+	if v, ok := tfMap["payload"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Payload = expandIotEventsPayload(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandIotEventsIotSiteWiseAction(tfMap map[string]interface{}) *iotevents.IotSiteWiseAction {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotevents.IotSiteWiseAction{}
+
+	if v, ok := tfMap["asset_id"].(string); ok && v != "" {
+		apiObject.AssetId = aws.String(v)
+	}
+
+	if v, ok := tfMap["entry_id"].(string); ok && v != "" {
+		apiObject.EntryId = aws.String(v)
+	}
+
+	if v, ok := tfMap["property_alias"].(string); ok && v != "" {
+		apiObject.PropertyAlias = aws.String(v)
+	}
+
+	if v, ok := tfMap["property_id"].(string); ok && v != "" {
+		apiObject.PropertyId = aws.String(v)
+	}
+
+	if v, ok := tfMap["property_value"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.PropertyValue = expandIotEventsAssetPropertyValue(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandIotEventsAssetPropertyValue(tfMap map[string]interface{}) *iotevents.AssetPropertyValue {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotevents.AssetPropertyValue{}
+
+	if v, ok := tfMap["quality"].(string); ok && v != "" {
+		apiObject.Quality = aws.String(v)
+	}
+
+	if v, ok := tfMap["timestamp"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		timestamp := &iotevents.AssetPropertyTimestamp{}
+
+		if v, ok := tfMap["offset_in_nanos"].(string); ok && v != "" {
+			timestamp.OffsetInNanos = aws.String(v)
+		}
+
+		if v, ok := tfMap["time_in_seconds"].(string); ok && v != "" {
+			timestamp.TimeInSeconds = aws.String(v)
+		}
+
+		apiObject.Timestamp = timestamp
+	}
+
+	if v, ok := tfMap["value"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		value := &iotevents.AssetPropertyVariant{}
+
+		if v, ok := tfMap["boolean_value"].(string); ok && v != "" {
+			value.BooleanValue = aws.String(v)
+		}
+
+		if v, ok := tfMap["double_value"].(string); ok && v != "" {
+			value.DoubleValue = aws.String(v)
+		}
+
+		if v, ok := tfMap["integer_value"].(string); ok && v != "" {
+			value.IntegerValue = aws.String(v)
+		}
+
+		if v, ok := tfMap["string_value"].(string); ok && v != "" {
+			value.StringValue = aws.String(v)
+		}
+
+		apiObject.Value = value
+	}
+
+	return apiObject
+}
+
+func expandIotEventsIotTopicPublishAction(tfMap map[string]interface{}) *iotevents.IotTopicPublishAction {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotevents.IotTopicPublishAction{}
+
+	if v, ok := tfMap["mqtt_topic"].(string); ok && v != "" {
+		apiObject.MqttTopic = aws.String(v)
+	}
+
+	if v, ok := tfMap["payload"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Payload = expandIotEventsPayload(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandIotEventsLambdaAction(tfMap map[string]interface{}) *iotevents.LambdaAction {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotevents.LambdaAction{}
+
+	if v, ok := tfMap["function_arn"].(string); ok && v != "" {
+		apiObject.FunctionArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["payload"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Payload = expandIotEventsPayload(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandIotEventsSNSTopicPublishAction(tfMap map[string]interface{}) *iotevents.SNSTopicPublishAction {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotevents.SNSTopicPublishAction{}
+
+	if v, ok := tfMap["payload"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Payload = expandIotEventsPayload(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["target_arn"].(string); ok && v != "" {
+		apiObject.TargetArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandIotEventsSqsAction(tfMap map[string]interface{}) *iotevents.SqsAction {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotevents.SqsAction{}
+
+	if v, ok := tfMap["payload"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Payload = expandIotEventsPayload(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["queue_url"].(string); ok && v != "" {
+		apiObject.QueueUrl = aws.String(v)
+	}
+
+	if v, ok := tfMap["use_base64"].(bool); ok {
+		apiObject.UseBase64 = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func expandIotEventsPayload(tfMap map[string]interface{}) *iotevents.Payload {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotevents.Payload{}
+
+	if v, ok := tfMap["content_expression"].(string); ok && v != "" {
+		apiObject.ContentExpression = aws.String(v)
+	}
+
+	if v, ok := tfMap["type"].(string); ok && v != "" {
+		apiObject.Type = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenIotEventsDetectorModelDefinition(apiObject *iotevents.DetectorModelDefinition) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.InitialStateName; v != nil {
+		tfMap["initial_state_name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.States; v != nil {
+		tfMap["state"] = flattenIotEventsStates(v)
+	}
+
+	return tfMap
+}
+
+func flattenIotEventsState(apiObject *iotevents.State) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.OnEnter; v != nil {
+		tfMap["on_enter"] = []interface{}{map[string]interface{}{
+			"event": flattenIotEventsEvents(v.Events),
+		}}
+	}
+
+	if v := apiObject.OnExit; v != nil {
+		tfMap["on_exit"] = []interface{}{map[string]interface{}{
+			"event": flattenIotEventsEvents(v.Events),
+		}}
+	}
+
+	if v := apiObject.OnInput; v != nil {
+		tfMap["on_input"] = []interface{}{map[string]interface{}{
+			"event":            flattenIotEventsEvents(v.Events),
+			"transition_event": flattenIotEventsTransitionEvents(v.TransitionEvents),
+		}}
+	}
+
+	if v := apiObject.StateName; v != nil {
+		tfMap["state_name"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenIotEventsStates(apiObjects []*iotevents.State) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenIotEventsState(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenIotEventsEvent(apiObject *iotevents.Event) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Actions; v != nil {
+		tfMap["action"] = flattenIotEventsActions(v)
+	}
+
+	if v := apiObject.Condition; v != nil {
+		tfMap["condition"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.EventName; v != nil {
+		tfMap["event_name"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenIotEventsEvents(apiObjects []*iotevents.Event) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenIotEventsEvent(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenIotEventsTransitionEvent(apiObject *iotevents.TransitionEvent) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Actions; v != nil {
+		tfMap["action"] = flattenIotEventsActions(v)
+	}
+
+	if v := apiObject.Condition; v != nil {
+		tfMap["condition"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.EventName; v != nil {
+		tfMap["event_name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.NextState; v != nil {
+		tfMap["next_state"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenIotEventsTransitionEvents(apiObjects []*iotevents.TransitionEvent) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenIotEventsTransitionEvent(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenIotEventsAction(apiObject *iotevents.ActionData) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ClearTimer; v != nil {
+		tfMap["clear_timer"] = []interface{}{map[string]interface{}{
+			"timer_name": aws.StringValue(v.TimerName),
+		}}
+	}
+
+	if v := apiObject.DynamoDB; v != nil {
+		tfMap["dynamodb"] = []interface{}{flattenIotEventsDynamoDBAction(v)}
+	}
+
+	if v := apiObject.DynamoDBv2; v != nil {
+		tfMap["dynamodb_v2"] = []interface{}{map[string]interface{}{
+			"payload":    flattenIotEventsPayload(v.Payload),
+			"table_name": aws.StringValue(v.TableName),
+		}}
+	}
+
+	if v := apiObject.Firehose; v != nil {
+		tfMap["firehose"] = []interface{}{map[string]interface{}{
+			"delivery_stream_name": aws.StringValue(v.DeliveryStreamName),
+			"payload":              flattenIotEventsPayload(v.Payload),
+			"separator":            aws.StringValue(v.Separator),
+		}}
+	}
+
+	if v := apiObject.IotEvents; v != nil {
+		tfMap["iot_events"] = []interface{}{map[string]interface{}{
+			"input_name": aws.StringValue(v.InputName),
+			"payload":    flattenIotEventsPayload(v.Payload),
+		}}
+	}
+
+	if v := apiObject.IotSiteWise; v != nil {
+		tfMap["iot_site_wise"] = []interface{}{flattenIotEventsIotSiteWiseAction(v)}
+	}
+
+	if v := apiObject.IotTopicPublish; v != nil {
+		tfMap["iot_topic_publish"] = []interface{}{map[string]interface{}{
+			"mqtt_topic": aws.StringValue(v.MqttTopic),
+			"payload":    flattenIotEventsPayload(v.Payload),
+		}}
+	}
+
+	if v := apiObject.Lambda; v != nil {
+		tfMap["lambda"] = []interface{}{map[string]interface{}{
+			"function_arn": aws.StringValue(v.FunctionArn),
+			"payload":      flattenIotEventsPayload(v.Payload),
+		}}
+	}
+
+	if v := apiObject.ResetTimer; v != nil {
+		tfMap["reset_timer"] = []interface{}{map[string]interface{}{
+			"timer_name": aws.StringValue(v.TimerName),
+		}}
+	}
+
+	if v := apiObject.SetTimer; v != nil {
+		tfMap["set_timer"] = []interface{}{map[string]interface{}{
+			"duration_expression": aws.StringValue(v.DurationExpression),
+			"timer_name":          aws.StringValue(v.TimerName),
+		}}
+	}
+
+	if v := apiObject.SetVariable; v != nil {
+		tfMap["set_variable"] = []interface{}{map[string]interface{}{
+			"value":         aws.StringValue(v.Value),
+			"variable_name": aws.StringValue(v.VariableName),
+		}}
+	}
+
+	if v := apiObject.Sns; v != nil {
+		tfMap["sns"] = []interface{}{map[string]interface{}{
+			"payload":    flattenIotEventsPayload(v.Payload),
+			"target_arn": aws.StringValue(v.TargetArn),
+		}}
+	}
+
+	if v := apiObject.Sqs; v != nil {
+		tfMap["sqs"] = []interface{}{map[string]interface{}{
+			"payload":    flattenIotEventsPayload(v.Payload),
+			"queue_url":  aws.StringValue(v.QueueUrl),
+			"use_base64": aws.BoolValue(v.UseBase64),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenIotEventsActions(apiObjects []*iotevents.ActionData) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenIotEventsAction(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenIotEventsDynamoDBAction(apiObject *iotevents.DynamoDBAction) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"payload": flattenIotEventsPayload(apiObject.Payload),
+	}
+
+	if v := apiObject.HashKeyField; v != nil {
+		tfMap["hash_key_field"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.HashKeyType; v != nil {
+		tfMap["hash_key_type"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.HashKeyValue; v != nil {
+		tfMap["hash_key_value"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Operation; v != nil {
+		tfMap["operation"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.PayloadField; v != nil {
+		tfMap["payload_field"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.RangeKeyField; v != nil {
+		tfMap["range_key_field"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.RangeKeyType; v != nil {
+		tfMap["range_key_type"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.RangeKeyValue; v != nil {
+		tfMap["range_key_value"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.TableName; v != nil {
+		tfMap["table_name"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenIotEventsIotSiteWiseAction(apiObject *iotevents.IotSiteWiseAction) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AssetId; v != nil {
+		tfMap["asset_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.EntryId; v != nil {
+		tfMap["entry_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.PropertyAlias; v != nil {
+		tfMap["property_alias"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.PropertyId; v != nil {
+		tfMap["property_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.PropertyValue; v != nil {
+		propertyValue := map[string]interface{}{
+			"quality": aws.StringValue(v.Quality),
+		}
+
+		if v := v.Timestamp; v != nil {
+			propertyValue["timestamp"] = []interface{}{map[string]interface{}{
+				"offset_in_nanos": aws.StringValue(v.OffsetInNanos),
+				"time_in_seconds": aws.StringValue(v.TimeInSeconds),
+			}}
+		}
+
+		if v := v.Value; v != nil {
+			propertyValue["value"] = []interface{}{map[string]interface{}{
+				"boolean_value": aws.StringValue(v.BooleanValue),
+				"double_value":  aws.StringValue(v.DoubleValue),
+				"integer_value": aws.StringValue(v.IntegerValue),
+				"string_value":  aws.StringValue(v.StringValue),
+			}}
+		}
+
+		tfMap["property_value"] = []interface{}{propertyValue}
+	}
+
+	return tfMap
+}
+
+func flattenIotEventsPayload(apiObject *iotevents.Payload) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ContentExpression; v != nil {
+		tfMap["content_expression"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Type; v != nil {
+		tfMap["type"] = aws.StringValue(v)
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,561 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iotevents/finder"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_iotevents_detector_model", &resource.Sweeper{
+		Name: "aws_iotevents_detector_model",
+		F:    testSweepIotEventsDetectorModels,
+	})
+}
+
+func testSweepIotEventsDetectorModels(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.(*AWSClient).ioteventsconn
+	input := &iotevents.ListDetectorModelsInput{}
+	var sweeperErrs *multierror.Error
+
+	for {
+		output, err := conn.ListDetectorModels(input)
+
+		if testSweepSkipSweepError(err) {
+			log.Printf("[WARN] Skipping IoT Events Detector Model sweep for %s: %s", region, err)
+			return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		}
+
+		if err != nil {
+			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving IoT Events Detector Models: %w", err))
+			return sweeperErrs.ErrorOrNil()
+		}
+
+		for _, detectorModelSummary := range output.DetectorModelSummaries {
+			name := aws.StringValue(detectorModelSummary.DetectorModelName)
+			log.Printf("[INFO] Deleting IoT Events Detector Model: %s", name)
+
+			r := resourceAwsIotEventsDetectorModel()
+			d := r.Data(nil)
+			d.SetId(name)
+
+			if err := r.Delete(d, client); err != nil {
+				log.Printf("[ERROR] %s", err)
+				sweeperErrs = multierror.Append(sweeperErrs, err)
+				continue
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestSuppressEquivalentIotEventsContentExpression(t *testing.T) {
+	testCases := []struct {
+		old        string
+		new        string
+		equivalent bool
+	}{
+		{
+			old:        `'{"a": "${$input.x}", "b": 1}'`,
+			new:        `'{"a":"${$input.x}","b":1}'`,
+			equivalent: true,
+		},
+		{
+			old:        `{"a": 1}`,
+			new:        `{ "a" : 1 }`,
+			equivalent: true,
+		},
+		{
+			old:        `'{"a": 1}'`,
+			new:        `'{"a": 2}'`,
+			equivalent: false,
+		},
+		{
+			old:        `$input.x`,
+			new:        `$input.y`,
+			equivalent: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		if got := suppressEquivalentIotEventsContentExpression("", tc.old, tc.new, nil); got != tc.equivalent {
+			t.Errorf("suppressEquivalentIotEventsContentExpression(%q, %q) = %t, expected %t", tc.old, tc.new, got, tc.equivalent)
+		}
+	}
+}
+
+func TestAccAWSIotEventsDetectorModel_basic(t *testing.T) {
+	var v iotevents.DetectorModel
+	rName := fmt.Sprintf("tf_acc_test_%d", acctest.RandInt())
+	resourceName := "aws_iotevents_detector_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(iotevents.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotEventsDetectorModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotEventsDetectorModelConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotEventsDetectorModelExists(resourceName, &v),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "iotevents", regexp.MustCompile(`detectorModel/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "definition.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.initial_state_name", "normal"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.state.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.state.0.state_name", "normal"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.state.0.on_input.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.state.0.on_input.0.transition_event.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.state.0.on_input.0.transition_event.0.next_state", "alarm"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.state.1.state_name", "alarm"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.state.1.on_enter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.state.1.on_enter.0.event.0.action.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.state.1.on_enter.0.event.0.action.0.set_variable.0.variable_name", "alarmed"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "evaluation_method", iotevents.EvaluationMethodBatch),
+					resource.TestCheckResourceAttr(resourceName, "key", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSIotEventsDetectorModel_disappears(t *testing.T) {
+	var v iotevents.DetectorModel
+	rName := fmt.Sprintf("tf_acc_test_%d", acctest.RandInt())
+	resourceName := "aws_iotevents_detector_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(iotevents.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotEventsDetectorModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotEventsDetectorModelConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotEventsDetectorModelExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsIotEventsDetectorModel(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSIotEventsDetectorModel_Tags(t *testing.T) {
+	var v iotevents.DetectorModel
+	rName := fmt.Sprintf("tf_acc_test_%d", acctest.RandInt())
+	resourceName := "aws_iotevents_detector_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(iotevents.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotEventsDetectorModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotEventsDetectorModelConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotEventsDetectorModelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSIotEventsDetectorModelConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotEventsDetectorModelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSIotEventsDetectorModelConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotEventsDetectorModelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSIotEventsDetectorModel_update(t *testing.T) {
+	var v iotevents.DetectorModel
+	rName := fmt.Sprintf("tf_acc_test_%d", acctest.RandInt())
+	resourceName := "aws_iotevents_detector_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(iotevents.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotEventsDetectorModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotEventsDetectorModelConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotEventsDetectorModelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.state.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				Config: testAccAWSIotEventsDetectorModelConfigUpdated(rName, "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotEventsDetectorModelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.state.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.state.0.on_input.0.event.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.state.0.on_input.0.event.0.action.0.set_timer.0.timer_name", "cooldown"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.state.1.on_enter.0.event.0.action.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.state.1.on_enter.0.event.0.action.1.sns.0.payload.0.type", iotevents.PayloadTypeJson),
+					resource.TestCheckResourceAttrPair(resourceName, "definition.0.state.1.on_enter.0.event.0.action.1.sns.0.target_arn", "aws_sns_topic.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttr(resourceName, "version", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSIotEventsDetectorModel_Key(t *testing.T) {
+	var v iotevents.DetectorModel
+	rName := fmt.Sprintf("tf_acc_test_%d", acctest.RandInt())
+	resourceName := "aws_iotevents_detector_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(iotevents.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotEventsDetectorModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotEventsDetectorModelConfigKey(rName, "SERIAL"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotEventsDetectorModelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "evaluation_method", iotevents.EvaluationMethodSerial),
+					resource.TestCheckResourceAttr(resourceName, "key", "deviceId"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSIotEventsDetectorModelExists(resourceName string, v *iotevents.DetectorModel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT Events Detector Model ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ioteventsconn
+
+		output, err := finder.DetectorModelByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("IoT Events Detector Model (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSIotEventsDetectorModelDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ioteventsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iotevents_detector_model" {
+			continue
+		}
+
+		output, err := finder.DetectorModelByName(conn, rs.Primary.ID)
+
+		if tfawserr.ErrCodeEquals(err, iotevents.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil {
+			return fmt.Errorf("IoT Events Detector Model (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSIotEventsDetectorModelConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "iotevents.${data.aws_partition.current.dns_suffix}"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iotevents_input" "test" {
+  name = %[1]q
+
+  definition {
+    attribute {
+      json_path = "deviceId"
+    }
+
+    attribute {
+      json_path = "temperature"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAWSIotEventsDetectorModelDefinition() string {
+	return `
+  definition {
+    initial_state_name = "normal"
+
+    state {
+      state_name = "normal"
+
+      on_input {
+        transition_event {
+          event_name = "overheated"
+          condition  = "$input.${aws_iotevents_input.test.name}.temperature > 100"
+          next_state = "alarm"
+        }
+      }
+    }
+
+    state {
+      state_name = "alarm"
+
+      on_enter {
+        event {
+          event_name = "raised"
+
+          action {
+            set_variable {
+              variable_name = "alarmed"
+              value         = "true"
+            }
+          }
+        }
+      }
+
+      on_input {
+        transition_event {
+          event_name = "cooled"
+          condition  = "$input.${aws_iotevents_input.test.name}.temperature <= 100"
+          next_state = "normal"
+        }
+      }
+    }
+  }
+`
+}
+
+func testAccAWSIotEventsDetectorModelConfig(rName string) string {
+	return composeConfig(
+		testAccAWSIotEventsDetectorModelConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_iotevents_detector_model" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+%[2]s
+}
+`, rName, testAccAWSIotEventsDetectorModelDefinition()))
+}
+
+func testAccAWSIotEventsDetectorModelConfigUpdated(rName, description string) string {
+	return composeConfig(
+		testAccAWSIotEventsDetectorModelConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_iotevents_detector_model" "test" {
+  name        = %[1]q
+  description = %[2]q
+  role_arn    = aws_iam_role.test.arn
+
+  definition {
+    initial_state_name = "normal"
+
+    state {
+      state_name = "normal"
+
+      on_input {
+        event {
+          event_name = "reading"
+          condition  = "true"
+
+          action {
+            set_timer {
+              timer_name          = "cooldown"
+              duration_expression = "60"
+            }
+          }
+        }
+
+        transition_event {
+          event_name = "overheated"
+          condition  = "$input.${aws_iotevents_input.test.name}.temperature > 100"
+          next_state = "alarm"
+        }
+      }
+    }
+
+    state {
+      state_name = "alarm"
+
+      on_enter {
+        event {
+          event_name = "raised"
+
+          action {
+            set_variable {
+              variable_name = "alarmed"
+              value         = "true"
+            }
+          }
+
+          action {
+            sns {
+              target_arn = aws_sns_topic.test.arn
+
+              payload {
+                type               = "JSON"
+                content_expression = "'{\"temperature\": \"$${$input.${aws_iotevents_input.test.name}.temperature}\"}'"
+              }
+            }
+          }
+        }
+      }
+
+      on_input {
+        transition_event {
+          event_name = "cooled"
+          condition  = "$input.${aws_iotevents_input.test.name}.temperature <= 100"
+          next_state = "normal"
+        }
+      }
+    }
+  }
+}
+`, rName, description))
+}
+
+func testAccAWSIotEventsDetectorModelConfigKey(rName, evaluationMethod string) string {
+	return composeConfig(
+		testAccAWSIotEventsDetectorModelConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_iotevents_detector_model" "test" {
+  name              = %[1]q
+  role_arn          = aws_iam_role.test.arn
+  key               = "deviceId"
+  evaluation_method = %[2]q
+%[3]s
+}
+`, rName, evaluationMethod, testAccAWSIotEventsDetectorModelDefinition()))
+}
+
+func testAccAWSIotEventsDetectorModelConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return composeConfig(
+		testAccAWSIotEventsDetectorModelConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_iotevents_detector_model" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+%[4]s
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1, testAccAWSIotEventsDetectorModelDefinition()))
+}
+
+func testAccAWSIotEventsDetectorModelConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(
+		testAccAWSIotEventsDetectorModelConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_iotevents_detector_model" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+%[6]s
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2, testAccAWSIotEventsDetectorModelDefinition()))
+}
@@ -0,0 +1,344 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iotevents/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iotevents/waiter"
+)
+
+func resourceAwsIotEventsInput() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIotEventsInputCreate,
+		Read:   resourceAwsIotEventsInputRead,
+		Update: resourceAwsIotEventsInputUpdate,
+		Delete: resourceAwsIotEventsInputDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"definition": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attribute": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 200,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"json_path": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.All(
+											validation.StringLenBetween(1, 128),
+											validation.StringMatch(regexp.MustCompile("^((`[\\w\\- ]+`)|([\\w\\-]+))(\\.((`[\\w- ]+`)|([\\w\\-]+)))*$"), "must be a valid JSON path"),
+										),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 128),
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]*$`), "must begin with a letter and contain only alphanumeric characters and underscores"),
+				),
+			},
+
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+		},
+	}
+}
+
+func resourceAwsIotEventsInputCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ioteventsconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+
+	input := &iotevents.CreateInputInput{
+		InputName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("definition"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.InputDefinition = expandIotEventsInputDefinition(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.InputDescription = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().IoteventsTags()
+	}
+
+	log.Printf("[DEBUG] Creating IoT Events Input: %s", input)
+	_, err := conn.CreateInput(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating IoT Events Input (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waiter.InputActive(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for IoT Events Input (%s) to become active: %w", d.Id(), err)
+	}
+
+	return resourceAwsIotEventsInputRead(d, meta)
+}
+
+func resourceAwsIotEventsInputRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ioteventsconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	output, err := finder.InputByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, iotevents.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] IoT Events Input (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading IoT Events Input (%s): %w", d.Id(), err)
+	}
+
+	if output == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading IoT Events Input (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] IoT Events Input (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	arn := aws.StringValue(output.InputConfiguration.InputArn)
+	d.Set("arn", arn)
+
+	if output.InputDefinition != nil {
+		if err := d.Set("definition", []interface{}{flattenIotEventsInputDefinition(output.InputDefinition)}); err != nil {
+			return fmt.Errorf("error setting definition: %w", err)
+		}
+	} else {
+		d.Set("definition", nil)
+	}
+
+	d.Set("description", output.InputConfiguration.InputDescription)
+	d.Set("name", output.InputConfiguration.InputName)
+
+	tags, err := keyvaluetags.IoteventsListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for IoT Events Input (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsIotEventsInputUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ioteventsconn
+
+	if d.HasChanges("definition", "description") {
+		input := &iotevents.UpdateInputInput{
+			InputDescription: aws.String(d.Get("description").(string)),
+			InputName:        aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("definition"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.InputDefinition = expandIotEventsInputDefinition(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating IoT Events Input: %s", input)
+		_, err := conn.UpdateInput(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating IoT Events Input (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waiter.InputActive(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for IoT Events Input (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.IoteventsUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating IoT Events Input (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsIotEventsInputRead(d, meta)
+}
+
+func resourceAwsIotEventsInputDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ioteventsconn
+
+	log.Printf("[DEBUG] Deleting IoT Events Input (%s)", d.Id())
+	_, err := conn.DeleteInput(&iotevents.DeleteInputInput{
+		InputName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotevents.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting IoT Events Input (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.InputDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for IoT Events Input (%s) to delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandIotEventsInputDefinition(tfMap map[string]interface{}) *iotevents.InputDefinition {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotevents.InputDefinition{}
+
+	if v, ok := tfMap["attribute"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Attributes = expandIotEventsAttributes(v)
+	}
+
+	return apiObject
+}
+
+func expandIotEventsAttribute(tfMap map[string]interface{}) *iotevents.Attribute {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotevents.Attribute{}
+
+	if v, ok := tfMap["json_path"].(string); ok && v != "" {
+		apiObject.JsonPath = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandIotEventsAttributes(tfList []interface{}) []*iotevents.Attribute {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*iotevents.Attribute
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandIotEventsAttribute(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenIotEventsInputDefinition(apiObject *iotevents.InputDefinition) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Attributes; v != nil {
+		tfMap["attribute"] = flattenIotEventsAttributes(v)
+	}
+
+	return tfMap
+}
+
+func flattenIotEventsAttribute(apiObject *iotevents.Attribute) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.JsonPath; v != nil {
+		tfMap["json_path"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenIotEventsAttributes(apiObjects []*iotevents.Attribute) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenIotEventsAttribute(apiObject))
+	}
+
+	return tfList
+}
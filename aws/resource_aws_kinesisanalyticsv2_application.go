@@ -0,0 +1,2538 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/kinesisanalyticsv2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	iamwaiter "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iam/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/kinesisanalyticsv2/waiter"
+)
+
+func resourceAwsKinesisAnalyticsV2Application() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsKinesisAnalyticsV2ApplicationCreate,
+		Read:   resourceAwsKinesisAnalyticsV2ApplicationRead,
+		Update: resourceAwsKinesisAnalyticsV2ApplicationUpdate,
+		Delete: resourceAwsKinesisAnalyticsV2ApplicationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsKinesisAnalyticsV2ApplicationImport,
+		},
+
+		CustomizeDiff: customdiff.All(
+			SetTagsDiff,
+			// An SQL application input cannot be deleted.
+			customdiff.ForceNewIfChange("application_configuration.0.sql_application_configuration.0.input", func(_ context.Context, old, new, meta interface{}) bool {
+				return len(old.([]interface{})) > len(new.([]interface{}))
+			}),
+		),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"application_code_configuration": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"code_content": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"s3_content_location": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"bucket_arn": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validateArn,
+															},
+
+															"file_key": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(1, 1024),
+															},
+
+															"object_version": {
+																Type:     schema.TypeString,
+																Optional: true,
+															},
+														},
+													},
+													ConflictsWith: []string{"application_configuration.0.application_code_configuration.0.code_content.0.text_content"},
+												},
+
+												"text_content": {
+													Type:          schema.TypeString,
+													Optional:      true,
+													ValidateFunc:  validation.StringLenBetween(0, 102400),
+													ConflictsWith: []string{"application_configuration.0.application_code_configuration.0.code_content.0.s3_content_location"},
+												},
+											},
+										},
+									},
+
+									"code_content_type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(kinesisanalyticsv2.CodeContentType_Values(), false),
+									},
+								},
+							},
+						},
+
+						"application_snapshot_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"snapshots_enabled": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+								},
+							},
+						},
+
+						"environment_properties": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"property_group": {
+										Type:     schema.TypeSet,
+										Required: true,
+										MaxItems: 50,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"property_group_id": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 50),
+												},
+
+												"property_map": {
+													Type:     schema.TypeMap,
+													Required: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+
+						"flink_application_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"checkpoint_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"checkpoint_interval": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+
+												"checkpointing_enabled": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													Computed: true,
+												},
+
+												"configuration_type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(kinesisanalyticsv2.ConfigurationType_Values(), false),
+												},
+
+												"min_pause_between_checkpoints": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.IntAtLeast(0),
+												},
+											},
+										},
+									},
+
+									"monitoring_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"configuration_type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(kinesisanalyticsv2.ConfigurationType_Values(), false),
+												},
+
+												"log_level": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.StringInSlice(kinesisanalyticsv2.LogLevel_Values(), false),
+												},
+
+												"metrics_level": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.StringInSlice(kinesisanalyticsv2.MetricsLevel_Values(), false),
+												},
+											},
+										},
+									},
+
+									"parallelism_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"auto_scaling_enabled": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													Computed: true,
+												},
+
+												"configuration_type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(kinesisanalyticsv2.ConfigurationType_Values(), false),
+												},
+
+												"parallelism": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+
+												"parallelism_per_kpu": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+
+						"run_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"application_restore_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"application_restore_type": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.StringInSlice(kinesisanalyticsv2.ApplicationRestoreType_Values(), false),
+												},
+
+												"snapshot_name": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(1, 256),
+												},
+											},
+										},
+									},
+
+									"flink_run_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"allow_non_restored_state": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													Computed: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+
+						"sql_application_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"input": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"in_app_stream_names": {
+													Type:     schema.TypeList,
+													Computed: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+
+												"input_id": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+
+												"input_parallelism": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Computed: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"count": {
+																Type:         schema.TypeInt,
+																Optional:     true,
+																Computed:     true,
+																ValidateFunc: validation.IntBetween(1, 64),
+															},
+														},
+													},
+												},
+
+												"input_processing_configuration": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"input_lambda_processor": {
+																Type:     schema.TypeList,
+																Required: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"resource_arn": {
+																			Type:         schema.TypeString,
+																			Required:     true,
+																			ValidateFunc: validateArn,
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+
+												"input_schema": kinesisAnalyticsV2SourceSchemaSchema(),
+
+												"input_starting_position_configuration": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Computed: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"input_starting_position": {
+																Type:         schema.TypeString,
+																Optional:     true,
+																Computed:     true,
+																ValidateFunc: validation.StringInSlice(kinesisanalyticsv2.InputStartingPosition_Values(), false),
+															},
+														},
+													},
+												},
+
+												"kinesis_firehose_input": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"resource_arn": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validateArn,
+															},
+														},
+													},
+													ExactlyOneOf: []string{
+														"application_configuration.0.sql_application_configuration.0.input.0.kinesis_firehose_input",
+														"application_configuration.0.sql_application_configuration.0.input.0.kinesis_streams_input",
+													},
+												},
+
+												"kinesis_streams_input": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"resource_arn": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validateArn,
+															},
+														},
+													},
+													ExactlyOneOf: []string{
+														"application_configuration.0.sql_application_configuration.0.input.0.kinesis_firehose_input",
+														"application_configuration.0.sql_application_configuration.0.input.0.kinesis_streams_input",
+													},
+												},
+
+												"name_prefix": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 32),
+												},
+											},
+										},
+									},
+
+									"output": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 3,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"destination_schema": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"record_format_type": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringInSlice(kinesisanalyticsv2.RecordFormatType_Values(), false),
+															},
+														},
+													},
+												},
+
+												"kinesis_firehose_output": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"resource_arn": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validateArn,
+															},
+														},
+													},
+												},
+
+												"kinesis_streams_output": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"resource_arn": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validateArn,
+															},
+														},
+													},
+												},
+
+												"lambda_output": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"resource_arn": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validateArn,
+															},
+														},
+													},
+												},
+
+												"name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 32),
+												},
+
+												"output_id": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+											},
+										},
+									},
+
+									"reference_data_source": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"reference_id": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+
+												"reference_schema": kinesisAnalyticsV2SourceSchemaSchema(),
+
+												"s3_reference_data_source": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"bucket_arn": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validateArn,
+															},
+
+															"file_key": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(1, 1024),
+															},
+														},
+													},
+												},
+
+												"table_name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 32),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+
+						"vpc_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"security_group_ids": {
+										Type:     schema.TypeSet,
+										Required: true,
+										MinItems: 1,
+										MaxItems: 5,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+
+									"subnet_ids": {
+										Type:     schema.TypeSet,
+										Required: true,
+										MinItems: 1,
+										MaxItems: 16,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+
+									"vpc_configuration_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"vpc_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"cloudwatch_logging_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cloudwatch_logging_option_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"log_stream_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+						},
+					},
+				},
+			},
+
+			"create_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "",
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+
+			"last_update_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_.-]+$`), "must only include alphanumeric, underscore, period, or hyphen characters"),
+				),
+			},
+
+			"runtime_environment": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(kinesisanalyticsv2.RuntimeEnvironment_Values(), false),
+			},
+
+			"service_execution_role": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+
+			"start_application": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+
+			"version_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// kinesisAnalyticsV2SourceSchemaSchema returns the schema for an SQL input or reference data source schema.
+func kinesisAnalyticsV2SourceSchemaSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"record_column": {
+					Type:     schema.TypeList,
+					Required: true,
+					MaxItems: 1000,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"mapping": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+
+							"name": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+
+							"sql_type": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+						},
+					},
+				},
+
+				"record_encoding": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice([]string{"UTF-8"}, false),
+				},
+
+				"record_format": {
+					Type:     schema.TypeList,
+					Required: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"mapping_parameters": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"csv_mapping_parameters": {
+											Type:     schema.TypeList,
+											Optional: true,
+											MaxItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"record_column_delimiter": {
+														Type:     schema.TypeString,
+														Required: true,
+													},
+
+													"record_row_delimiter": {
+														Type:     schema.TypeString,
+														Required: true,
+													},
+												},
+											},
+										},
+
+										"json_mapping_parameters": {
+											Type:     schema.TypeList,
+											Optional: true,
+											MaxItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"record_row_path": {
+														Type:     schema.TypeString,
+														Required: true,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+
+							"record_format_type": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringInSlice(kinesisanalyticsv2.RecordFormatType_Values(), false),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsKinesisAnalyticsV2ApplicationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kinesisanalyticsv2conn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	applicationName := d.Get("name").(string)
+
+	input := &kinesisanalyticsv2.CreateApplicationInput{
+		ApplicationConfiguration: expandKinesisAnalyticsV2ApplicationConfiguration(d.Get("application_configuration").([]interface{})),
+		ApplicationName:          aws.String(applicationName),
+		CloudWatchLoggingOptions: expandKinesisAnalyticsV2CloudWatchLoggingOptions(d.Get("cloudwatch_logging_options").([]interface{})),
+		RuntimeEnvironment:       aws.String(d.Get("runtime_environment").(string)),
+		ServiceExecutionRole:     aws.String(d.Get("service_execution_role").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.ApplicationDescription = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().Kinesisanalyticsv2Tags()
+	}
+
+	log.Printf("[DEBUG] Creating Kinesis Analytics v2 Application: %s", input)
+
+	outputRaw, err := kinesisAnalyticsV2RetryIAMEventualConsistency(func() (interface{}, error) {
+		return conn.CreateApplication(input)
+	})
+
+	if err != nil {
+		return fmt.Errorf("error creating Kinesis Analytics v2 Application (%s): %w", applicationName, err)
+	}
+
+	application := outputRaw.(*kinesisanalyticsv2.CreateApplicationOutput).ApplicationDetail
+
+	d.SetId(aws.StringValue(application.ApplicationARN))
+
+	if d.Get("start_application").(bool) {
+		if err := kinesisAnalyticsV2StartApplication(conn, application, d.Get("application_configuration").([]interface{}), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsKinesisAnalyticsV2ApplicationRead(d, meta)
+}
+
+func resourceAwsKinesisAnalyticsV2ApplicationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kinesisanalyticsv2conn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	output, err := conn.DescribeApplication(&kinesisanalyticsv2.DescribeApplicationInput{
+		ApplicationName: aws.String(d.Get("name").(string)),
+	})
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, kinesisanalyticsv2.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Kinesis Analytics v2 Application (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Kinesis Analytics v2 Application (%s): %w", d.Id(), err)
+	}
+
+	if output == nil || output.ApplicationDetail == nil {
+		return fmt.Errorf("error reading Kinesis Analytics v2 Application (%s): empty response", d.Id())
+	}
+
+	application := output.ApplicationDetail
+
+	arn := aws.StringValue(application.ApplicationARN)
+	d.Set("arn", arn)
+	d.Set("create_timestamp", aws.TimeValue(application.CreateTimestamp).Format(time.RFC3339))
+	d.Set("description", application.ApplicationDescription)
+	if application.LastUpdateTimestamp != nil {
+		d.Set("last_update_timestamp", aws.TimeValue(application.LastUpdateTimestamp).Format(time.RFC3339))
+	} else {
+		d.Set("last_update_timestamp", nil)
+	}
+	d.Set("name", application.ApplicationName)
+	d.Set("runtime_environment", application.RuntimeEnvironment)
+	d.Set("service_execution_role", application.ServiceExecutionRole)
+	d.Set("status", application.ApplicationStatus)
+	d.Set("version_id", aws.Int64Value(application.ApplicationVersionId))
+
+	if err := d.Set("application_configuration", flattenKinesisAnalyticsV2ApplicationConfigurationDescription(application.ApplicationConfigurationDescription)); err != nil {
+		return fmt.Errorf("error setting application_configuration: %w", err)
+	}
+
+	if err := d.Set("cloudwatch_logging_options", flattenKinesisAnalyticsV2CloudWatchLoggingOptionDescriptions(application.CloudWatchLoggingOptionDescriptions)); err != nil {
+		return fmt.Errorf("error setting cloudwatch_logging_options: %w", err)
+	}
+
+	tags, err := keyvaluetags.Kinesisanalyticsv2ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Kinesis Analytics v2 Application (%s): %w", arn, err)
+	}
+
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsKinesisAnalyticsV2ApplicationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kinesisanalyticsv2conn
+	applicationName := d.Get("name").(string)
+	currentApplicationVersionId := int64(d.Get("version_id").(int))
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	updateApplication := false
+
+	input := &kinesisanalyticsv2.UpdateApplicationInput{
+		ApplicationName: aws.String(applicationName),
+	}
+
+	if d.HasChange("application_configuration") {
+		applicationConfigurationUpdate := &kinesisanalyticsv2.ApplicationConfigurationUpdate{}
+
+		if d.HasChange("application_configuration.0.application_code_configuration") {
+			applicationConfigurationUpdate.ApplicationCodeConfigurationUpdate = expandKinesisAnalyticsV2ApplicationCodeConfigurationUpdate(d.Get("application_configuration.0.application_code_configuration").([]interface{}))
+
+			updateApplication = true
+		}
+
+		if d.HasChange("application_configuration.0.application_snapshot_configuration") {
+			applicationConfigurationUpdate.ApplicationSnapshotConfigurationUpdate = expandKinesisAnalyticsV2ApplicationSnapshotConfigurationUpdate(d.Get("application_configuration.0.application_snapshot_configuration").([]interface{}))
+
+			updateApplication = true
+		}
+
+		if d.HasChange("application_configuration.0.environment_properties") {
+			environmentPropertyUpdates := &kinesisanalyticsv2.EnvironmentPropertyUpdates{
+				PropertyGroups: []*kinesisanalyticsv2.PropertyGroup{},
+			}
+
+			if environmentProperties := expandKinesisAnalyticsV2EnvironmentProperties(d.Get("application_configuration.0.environment_properties").([]interface{})); environmentProperties != nil {
+				environmentPropertyUpdates.PropertyGroups = environmentProperties.PropertyGroups
+			}
+
+			applicationConfigurationUpdate.EnvironmentPropertyUpdates = environmentPropertyUpdates
+
+			updateApplication = true
+		}
+
+		if d.HasChange("application_configuration.0.flink_application_configuration") {
+			applicationConfigurationUpdate.FlinkApplicationConfigurationUpdate = expandKinesisAnalyticsV2FlinkApplicationConfigurationUpdate(d.Get("application_configuration.0.flink_application_configuration").([]interface{}))
+
+			updateApplication = true
+		}
+
+		if d.HasChange("application_configuration.0.sql_application_configuration") {
+			sqlApplicationConfigurationUpdate := &kinesisanalyticsv2.SqlApplicationConfigurationUpdate{}
+
+			if d.HasChange("application_configuration.0.sql_application_configuration.0.input") {
+				o, n := d.GetChange("application_configuration.0.sql_application_configuration.0.input")
+
+				if len(o.([]interface{})) == 0 {
+					// Add new input.
+					outputRaw, err := kinesisAnalyticsV2RetryIAMEventualConsistency(func() (interface{}, error) {
+						return conn.AddApplicationInput(&kinesisanalyticsv2.AddApplicationInputInput{
+							ApplicationName:             aws.String(applicationName),
+							CurrentApplicationVersionId: aws.Int64(currentApplicationVersionId),
+							Input:                       expandKinesisAnalyticsV2Input(n.([]interface{})),
+						})
+					})
+
+					if err != nil {
+						return fmt.Errorf("error adding Kinesis Analytics v2 Application (%s) input: %w", d.Id(), err)
+					}
+
+					currentApplicationVersionId = aws.Int64Value(outputRaw.(*kinesisanalyticsv2.AddApplicationInputOutput).ApplicationVersionId)
+
+					if _, err := waiter.ApplicationUpdated(conn, applicationName, timeout); err != nil {
+						return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) to update: %w", d.Id(), err)
+					}
+				} else if len(n.([]interface{})) > 0 {
+					// Update existing input.
+					inputUpdate := expandKinesisAnalyticsV2InputUpdate(n.([]interface{}))
+					inputUpdate.InputId = aws.String(d.Get("application_configuration.0.sql_application_configuration.0.input.0.input_id").(string))
+
+					if d.HasChange("application_configuration.0.sql_application_configuration.0.input.0.input_processing_configuration") {
+						o, n := d.GetChange("application_configuration.0.sql_application_configuration.0.input.0.input_processing_configuration")
+
+						if len(o.([]interface{})) == 0 {
+							// Add new input processing configuration.
+							outputRaw, err := kinesisAnalyticsV2RetryIAMEventualConsistency(func() (interface{}, error) {
+								return conn.AddApplicationInputProcessingConfiguration(&kinesisanalyticsv2.AddApplicationInputProcessingConfigurationInput{
+									ApplicationName:              aws.String(applicationName),
+									CurrentApplicationVersionId:  aws.Int64(currentApplicationVersionId),
+									InputId:                      inputUpdate.InputId,
+									InputProcessingConfiguration: expandKinesisAnalyticsV2InputProcessingConfiguration(n.([]interface{})),
+								})
+							})
+
+							if err != nil {
+								return fmt.Errorf("error adding Kinesis Analytics v2 Application (%s) input processing configuration: %w", d.Id(), err)
+							}
+
+							currentApplicationVersionId = aws.Int64Value(outputRaw.(*kinesisanalyticsv2.AddApplicationInputProcessingConfigurationOutput).ApplicationVersionId)
+
+							if _, err := waiter.ApplicationUpdated(conn, applicationName, timeout); err != nil {
+								return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) to update: %w", d.Id(), err)
+							}
+						} else if len(n.([]interface{})) == 0 {
+							// Delete existing input processing configuration.
+							output, err := conn.DeleteApplicationInputProcessingConfiguration(&kinesisanalyticsv2.DeleteApplicationInputProcessingConfigurationInput{
+								ApplicationName:             aws.String(applicationName),
+								CurrentApplicationVersionId: aws.Int64(currentApplicationVersionId),
+								InputId:                     inputUpdate.InputId,
+							})
+
+							if err != nil {
+								return fmt.Errorf("error deleting Kinesis Analytics v2 Application (%s) input processing configuration: %w", d.Id(), err)
+							}
+
+							currentApplicationVersionId = aws.Int64Value(output.ApplicationVersionId)
+
+							if _, err := waiter.ApplicationUpdated(conn, applicationName, timeout); err != nil {
+								return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) to update: %w", d.Id(), err)
+							}
+						} else {
+							// Update existing input processing configuration.
+							inputUpdate.InputProcessingConfigurationUpdate = expandKinesisAnalyticsV2InputProcessingConfigurationUpdate(n.([]interface{}))
+						}
+					}
+
+					sqlApplicationConfigurationUpdate.InputUpdates = []*kinesisanalyticsv2.InputUpdate{inputUpdate}
+
+					updateApplication = true
+				}
+			}
+
+			if d.HasChange("application_configuration.0.sql_application_configuration.0.output") {
+				o, n := d.GetChange("application_configuration.0.sql_application_configuration.0.output")
+				os := o.([]interface{})
+				ns := n.([]interface{})
+
+				for i, vOutput := range ns {
+					if i < len(os) {
+						// Update existing output.
+						if !d.HasChange(fmt.Sprintf("application_configuration.0.sql_application_configuration.0.output.%d", i)) {
+							continue
+						}
+
+						outputUpdate := expandKinesisAnalyticsV2OutputUpdate(vOutput)
+						outputUpdate.OutputId = aws.String(os[i].(map[string]interface{})["output_id"].(string))
+
+						sqlApplicationConfigurationUpdate.OutputUpdates = append(sqlApplicationConfigurationUpdate.OutputUpdates, outputUpdate)
+
+						updateApplication = true
+
+						continue
+					}
+
+					// Add new output.
+					outputRaw, err := kinesisAnalyticsV2RetryIAMEventualConsistency(func() (interface{}, error) {
+						return conn.AddApplicationOutput(&kinesisanalyticsv2.AddApplicationOutputInput{
+							ApplicationName:             aws.String(applicationName),
+							CurrentApplicationVersionId: aws.Int64(currentApplicationVersionId),
+							Output:                      expandKinesisAnalyticsV2Output(vOutput),
+						})
+					})
+
+					if err != nil {
+						return fmt.Errorf("error adding Kinesis Analytics v2 Application (%s) output: %w", d.Id(), err)
+					}
+
+					currentApplicationVersionId = aws.Int64Value(outputRaw.(*kinesisanalyticsv2.AddApplicationOutputOutput).ApplicationVersionId)
+
+					if _, err := waiter.ApplicationUpdated(conn, applicationName, timeout); err != nil {
+						return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) to update: %w", d.Id(), err)
+					}
+				}
+
+				for i := len(ns); i < len(os); i++ {
+					// Delete existing output.
+					output, err := conn.DeleteApplicationOutput(&kinesisanalyticsv2.DeleteApplicationOutputInput{
+						ApplicationName:             aws.String(applicationName),
+						CurrentApplicationVersionId: aws.Int64(currentApplicationVersionId),
+						OutputId:                    aws.String(os[i].(map[string]interface{})["output_id"].(string)),
+					})
+
+					if err != nil {
+						return fmt.Errorf("error deleting Kinesis Analytics v2 Application (%s) output: %w", d.Id(), err)
+					}
+
+					currentApplicationVersionId = aws.Int64Value(output.ApplicationVersionId)
+
+					if _, err := waiter.ApplicationUpdated(conn, applicationName, timeout); err != nil {
+						return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) to update: %w", d.Id(), err)
+					}
+				}
+			}
+
+			if d.HasChange("application_configuration.0.sql_application_configuration.0.reference_data_source") {
+				o, n := d.GetChange("application_configuration.0.sql_application_configuration.0.reference_data_source")
+
+				if len(o.([]interface{})) == 0 {
+					// Add new reference data source.
+					outputRaw, err := kinesisAnalyticsV2RetryIAMEventualConsistency(func() (interface{}, error) {
+						return conn.AddApplicationReferenceDataSource(&kinesisanalyticsv2.AddApplicationReferenceDataSourceInput{
+							ApplicationName:             aws.String(applicationName),
+							CurrentApplicationVersionId: aws.Int64(currentApplicationVersionId),
+							ReferenceDataSource:         expandKinesisAnalyticsV2ReferenceDataSource(n.([]interface{})),
+						})
+					})
+
+					if err != nil {
+						return fmt.Errorf("error adding Kinesis Analytics v2 Application (%s) reference data source: %w", d.Id(), err)
+					}
+
+					currentApplicationVersionId = aws.Int64Value(outputRaw.(*kinesisanalyticsv2.AddApplicationReferenceDataSourceOutput).ApplicationVersionId)
+
+					if _, err := waiter.ApplicationUpdated(conn, applicationName, timeout); err != nil {
+						return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) to update: %w", d.Id(), err)
+					}
+				} else if len(n.([]interface{})) == 0 {
+					// Delete existing reference data source.
+					output, err := conn.DeleteApplicationReferenceDataSource(&kinesisanalyticsv2.DeleteApplicationReferenceDataSourceInput{
+						ApplicationName:             aws.String(applicationName),
+						CurrentApplicationVersionId: aws.Int64(currentApplicationVersionId),
+						ReferenceId:                 aws.String(o.([]interface{})[0].(map[string]interface{})["reference_id"].(string)),
+					})
+
+					if err != nil {
+						return fmt.Errorf("error deleting Kinesis Analytics v2 Application (%s) reference data source: %w", d.Id(), err)
+					}
+
+					currentApplicationVersionId = aws.Int64Value(output.ApplicationVersionId)
+
+					if _, err := waiter.ApplicationUpdated(conn, applicationName, timeout); err != nil {
+						return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) to update: %w", d.Id(), err)
+					}
+				} else {
+					// Update existing reference data source.
+					referenceDataSource := expandKinesisAnalyticsV2ReferenceDataSource(n.([]interface{}))
+
+					referenceDataSourceUpdate := &kinesisanalyticsv2.ReferenceDataSourceUpdate{
+						ReferenceId:           aws.String(o.([]interface{})[0].(map[string]interface{})["reference_id"].(string)),
+						ReferenceSchemaUpdate: referenceDataSource.ReferenceSchema,
+						TableNameUpdate:       referenceDataSource.TableName,
+					}
+
+					if s3ReferenceDataSource := referenceDataSource.S3ReferenceDataSource; s3ReferenceDataSource != nil {
+						referenceDataSourceUpdate.S3ReferenceDataSourceUpdate = &kinesisanalyticsv2.S3ReferenceDataSourceUpdate{
+							BucketARNUpdate: s3ReferenceDataSource.BucketARN,
+							FileKeyUpdate:   s3ReferenceDataSource.FileKey,
+						}
+					}
+
+					sqlApplicationConfigurationUpdate.ReferenceDataSourceUpdates = []*kinesisanalyticsv2.ReferenceDataSourceUpdate{referenceDataSourceUpdate}
+
+					updateApplication = true
+				}
+			}
+
+			if len(sqlApplicationConfigurationUpdate.InputUpdates) > 0 || len(sqlApplicationConfigurationUpdate.OutputUpdates) > 0 || len(sqlApplicationConfigurationUpdate.ReferenceDataSourceUpdates) > 0 {
+				applicationConfigurationUpdate.SqlApplicationConfigurationUpdate = sqlApplicationConfigurationUpdate
+			}
+		}
+
+		if d.HasChange("application_configuration.0.vpc_configuration") {
+			o, n := d.GetChange("application_configuration.0.vpc_configuration")
+
+			if len(o.([]interface{})) == 0 {
+				// Add new VPC configuration.
+				outputRaw, err := kinesisAnalyticsV2RetryIAMEventualConsistency(func() (interface{}, error) {
+					return conn.AddApplicationVpcConfiguration(&kinesisanalyticsv2.AddApplicationVpcConfigurationInput{
+						ApplicationName:             aws.String(applicationName),
+						CurrentApplicationVersionId: aws.Int64(currentApplicationVersionId),
+						VpcConfiguration:            expandKinesisAnalyticsV2VpcConfiguration(n.([]interface{})),
+					})
+				})
+
+				if err != nil {
+					return fmt.Errorf("error adding Kinesis Analytics v2 Application (%s) VPC configuration: %w", d.Id(), err)
+				}
+
+				currentApplicationVersionId = aws.Int64Value(outputRaw.(*kinesisanalyticsv2.AddApplicationVpcConfigurationOutput).ApplicationVersionId)
+
+				if _, err := waiter.ApplicationUpdated(conn, applicationName, timeout); err != nil {
+					return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) to update: %w", d.Id(), err)
+				}
+			} else if len(n.([]interface{})) == 0 {
+				// Delete existing VPC configuration.
+				output, err := conn.DeleteApplicationVpcConfiguration(&kinesisanalyticsv2.DeleteApplicationVpcConfigurationInput{
+					ApplicationName:             aws.String(applicationName),
+					CurrentApplicationVersionId: aws.Int64(currentApplicationVersionId),
+					VpcConfigurationId:          aws.String(o.([]interface{})[0].(map[string]interface{})["vpc_configuration_id"].(string)),
+				})
+
+				if err != nil {
+					return fmt.Errorf("error deleting Kinesis Analytics v2 Application (%s) VPC configuration: %w", d.Id(), err)
+				}
+
+				currentApplicationVersionId = aws.Int64Value(output.ApplicationVersionId)
+
+				if _, err := waiter.ApplicationUpdated(conn, applicationName, timeout); err != nil {
+					return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) to update: %w", d.Id(), err)
+				}
+			} else {
+				// Update existing VPC configuration.
+				vpcConfiguration := expandKinesisAnalyticsV2VpcConfiguration(n.([]interface{}))
+
+				applicationConfigurationUpdate.VpcConfigurationUpdates = []*kinesisanalyticsv2.VpcConfigurationUpdate{
+					{
+						SecurityGroupIdUpdates: vpcConfiguration.SecurityGroupIds,
+						SubnetIdUpdates:        vpcConfiguration.SubnetIds,
+						VpcConfigurationId:     aws.String(o.([]interface{})[0].(map[string]interface{})["vpc_configuration_id"].(string)),
+					},
+				}
+
+				updateApplication = true
+			}
+		}
+
+		input.ApplicationConfigurationUpdate = applicationConfigurationUpdate
+	}
+
+	if d.HasChange("cloudwatch_logging_options") {
+		o, n := d.GetChange("cloudwatch_logging_options")
+
+		if len(o.([]interface{})) == 0 {
+			// Add new CloudWatch logging options.
+			outputRaw, err := kinesisAnalyticsV2RetryIAMEventualConsistency(func() (interface{}, error) {
+				return conn.AddApplicationCloudWatchLoggingOption(&kinesisanalyticsv2.AddApplicationCloudWatchLoggingOptionInput{
+					ApplicationName:             aws.String(applicationName),
+					CloudWatchLoggingOption:     expandKinesisAnalyticsV2CloudWatchLoggingOptions(n.([]interface{}))[0],
+					CurrentApplicationVersionId: aws.Int64(currentApplicationVersionId),
+				})
+			})
+
+			if err != nil {
+				return fmt.Errorf("error adding Kinesis Analytics v2 Application (%s) CloudWatch logging option: %w", d.Id(), err)
+			}
+
+			currentApplicationVersionId = aws.Int64Value(outputRaw.(*kinesisanalyticsv2.AddApplicationCloudWatchLoggingOptionOutput).ApplicationVersionId)
+
+			if _, err := waiter.ApplicationUpdated(conn, applicationName, timeout); err != nil {
+				return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) to update: %w", d.Id(), err)
+			}
+		} else if len(n.([]interface{})) == 0 {
+			// Delete existing CloudWatch logging options.
+			output, err := conn.DeleteApplicationCloudWatchLoggingOption(&kinesisanalyticsv2.DeleteApplicationCloudWatchLoggingOptionInput{
+				ApplicationName:             aws.String(applicationName),
+				CloudWatchLoggingOptionId:   aws.String(o.([]interface{})[0].(map[string]interface{})["cloudwatch_logging_option_id"].(string)),
+				CurrentApplicationVersionId: aws.Int64(currentApplicationVersionId),
+			})
+
+			if err != nil {
+				return fmt.Errorf("error deleting Kinesis Analytics v2 Application (%s) CloudWatch logging option: %w", d.Id(), err)
+			}
+
+			currentApplicationVersionId = aws.Int64Value(output.ApplicationVersionId)
+
+			if _, err := waiter.ApplicationUpdated(conn, applicationName, timeout); err != nil {
+				return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) to update: %w", d.Id(), err)
+			}
+		} else {
+			// Update existing CloudWatch logging options.
+			input.CloudWatchLoggingOptionUpdates = []*kinesisanalyticsv2.CloudWatchLoggingOptionUpdate{
+				{
+					CloudWatchLoggingOptionId: aws.String(o.([]interface{})[0].(map[string]interface{})["cloudwatch_logging_option_id"].(string)),
+					LogStreamARNUpdate:        aws.String(n.([]interface{})[0].(map[string]interface{})["log_stream_arn"].(string)),
+				},
+			}
+
+			updateApplication = true
+		}
+	}
+
+	if d.HasChange("service_execution_role") {
+		input.ServiceExecutionRoleUpdate = aws.String(d.Get("service_execution_role").(string))
+
+		updateApplication = true
+	}
+
+	// A running application's run configuration can be updated.
+	// Otherwise the run configuration is used when the application is next started.
+	if d.HasChange("application_configuration.0.run_configuration") && d.Get("start_application").(bool) && !d.HasChange("start_application") {
+		input.RunConfigurationUpdate = expandKinesisAnalyticsV2RunConfigurationUpdate(d.Get("application_configuration.0.run_configuration").([]interface{}))
+
+		updateApplication = true
+	}
+
+	if updateApplication {
+		input.CurrentApplicationVersionId = aws.Int64(currentApplicationVersionId)
+
+		log.Printf("[DEBUG] Updating Kinesis Analytics v2 Application (%s): %s", d.Id(), input)
+
+		_, err := kinesisAnalyticsV2RetryIAMEventualConsistency(func() (interface{}, error) {
+			return conn.UpdateApplication(input)
+		})
+
+		if err != nil {
+			return fmt.Errorf("error updating Kinesis Analytics v2 Application (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waiter.ApplicationUpdated(conn, applicationName, timeout); err != nil {
+			return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) to update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("start_application") {
+		if d.Get("start_application").(bool) {
+			output, err := conn.DescribeApplication(&kinesisanalyticsv2.DescribeApplicationInput{
+				ApplicationName: aws.String(applicationName),
+			})
+
+			if err != nil {
+				return fmt.Errorf("error reading Kinesis Analytics v2 Application (%s): %w", d.Id(), err)
+			}
+
+			if err := kinesisAnalyticsV2StartApplication(conn, output.ApplicationDetail, d.Get("application_configuration").([]interface{}), timeout); err != nil {
+				return err
+			}
+		} else {
+			if err := kinesisAnalyticsV2StopApplication(conn, applicationName, timeout); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.Kinesisanalyticsv2UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Kinesis Analytics v2 Application (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsKinesisAnalyticsV2ApplicationRead(d, meta)
+}
+
+func resourceAwsKinesisAnalyticsV2ApplicationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kinesisanalyticsv2conn
+	applicationName := d.Get("name").(string)
+
+	createTimestamp, err := time.Parse(time.RFC3339, d.Get("create_timestamp").(string))
+
+	if err != nil {
+		return fmt.Errorf("error parsing Kinesis Analytics v2 Application (%s) create timestamp: %w", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Deleting Kinesis Analytics v2 Application (%s)", d.Id())
+	_, err = conn.DeleteApplication(&kinesisanalyticsv2.DeleteApplicationInput{
+		ApplicationName: aws.String(applicationName),
+		CreateTimestamp: aws.Time(createTimestamp),
+	})
+
+	if tfawserr.ErrCodeEquals(err, kinesisanalyticsv2.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Kinesis Analytics v2 Application (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.ApplicationDeleted(conn, applicationName, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) deletion: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsKinesisAnalyticsV2ApplicationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	arn, err := arn.Parse(d.Id())
+
+	if err != nil {
+		return []*schema.ResourceData{}, fmt.Errorf("error parsing ARN (%s): %w", d.Id(), err)
+	}
+
+	// application/<name>
+	parts := strings.Split(arn.Resource, "/")
+
+	if len(parts) != 2 || parts[0] != "application" || parts[1] == "" {
+		return []*schema.ResourceData{}, fmt.Errorf("unexpected format of ARN (%s), expected arn:PARTITION:kinesisanalytics:REGION:ACCOUNT-ID:application/NAME", d.Id())
+	}
+
+	d.Set("name", parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// kinesisAnalyticsV2StartApplication starts the specified application and waits for it to be running.
+func kinesisAnalyticsV2StartApplication(conn *kinesisanalyticsv2.KinesisAnalyticsV2, application *kinesisanalyticsv2.ApplicationDetail, vApplicationConfiguration []interface{}, timeout time.Duration) error {
+	applicationARN := aws.StringValue(application.ApplicationARN)
+	applicationName := aws.StringValue(application.ApplicationName)
+
+	input := &kinesisanalyticsv2.StartApplicationInput{
+		ApplicationName:  aws.String(applicationName),
+		RunConfiguration: &kinesisanalyticsv2.RunConfiguration{},
+	}
+
+	if len(vApplicationConfiguration) > 0 && vApplicationConfiguration[0] != nil {
+		mApplicationConfiguration := vApplicationConfiguration[0].(map[string]interface{})
+
+		if v, ok := mApplicationConfiguration["run_configuration"].([]interface{}); ok {
+			if runConfiguration := expandKinesisAnalyticsV2RunConfiguration(v); runConfiguration != nil {
+				input.RunConfiguration = runConfiguration
+			}
+		}
+
+		// Each SQL application input must be started.
+		if applicationConfigurationDescription := application.ApplicationConfigurationDescription; applicationConfigurationDescription != nil {
+			if sqlApplicationConfigurationDescription := applicationConfigurationDescription.SqlApplicationConfigurationDescription; sqlApplicationConfigurationDescription != nil {
+				inputStartingPosition := kinesisanalyticsv2.InputStartingPositionNow
+
+				if v, ok := mApplicationConfiguration["sql_application_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+					if v, ok := v[0].(map[string]interface{})["input"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+						if v, ok := v[0].(map[string]interface{})["input_starting_position_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+							if v, ok := v[0].(map[string]interface{})["input_starting_position"].(string); ok && v != "" {
+								inputStartingPosition = v
+							}
+						}
+					}
+				}
+
+				for _, inputDescription := range sqlApplicationConfigurationDescription.InputDescriptions {
+					input.RunConfiguration.SqlRunConfigurations = append(input.RunConfiguration.SqlRunConfigurations, &kinesisanalyticsv2.SqlRunConfiguration{
+						InputId: inputDescription.InputId,
+						InputStartingPositionConfiguration: &kinesisanalyticsv2.InputStartingPositionConfiguration{
+							InputStartingPosition: aws.String(inputStartingPosition),
+						},
+					})
+				}
+			}
+		}
+	}
+
+	log.Printf("[DEBUG] Starting Kinesis Analytics v2 Application (%s): %s", applicationARN, input)
+	if _, err := conn.StartApplication(input); err != nil {
+		return fmt.Errorf("error starting Kinesis Analytics v2 Application (%s): %w", applicationARN, err)
+	}
+
+	if _, err := waiter.ApplicationStarted(conn, applicationName, timeout); err != nil {
+		return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) to start: %w", applicationARN, err)
+	}
+
+	return nil
+}
+
+// kinesisAnalyticsV2StopApplication stops the specified application and waits for it to be ready.
+func kinesisAnalyticsV2StopApplication(conn *kinesisanalyticsv2.KinesisAnalyticsV2, applicationName string, timeout time.Duration) error {
+	input := &kinesisanalyticsv2.StopApplicationInput{
+		ApplicationName: aws.String(applicationName),
+	}
+
+	log.Printf("[DEBUG] Stopping Kinesis Analytics v2 Application (%s): %s", applicationName, input)
+	if _, err := conn.StopApplication(input); err != nil {
+		return fmt.Errorf("error stopping Kinesis Analytics v2 Application (%s): %w", applicationName, err)
+	}
+
+	if _, err := waiter.ApplicationStopped(conn, applicationName, timeout); err != nil {
+		return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) to stop: %w", applicationName, err)
+	}
+
+	return nil
+}
+
+// kinesisAnalyticsV2RetryIAMEventualConsistency retries the specified function for IAM eventual consistency.
+func kinesisAnalyticsV2RetryIAMEventualConsistency(f func() (interface{}, error)) (interface{}, error) {
+	var output interface{}
+
+	err := resource.Retry(iamwaiter.PropagationTimeout, func() *resource.RetryError {
+		var err error
+
+		output, err = f()
+
+		// Kinesis Stream: https://github.com/terraform-providers/terraform-provider-aws/issues/7032
+		if tfawserr.ErrMessageContains(err, kinesisanalyticsv2.ErrCodeInvalidArgumentException, "Kinesis Analytics service doesn't have sufficient privileges") {
+			return resource.RetryableError(err)
+		}
+
+		// Kinesis Firehose: https://github.com/terraform-providers/terraform-provider-aws/issues/7394
+		if tfawserr.ErrMessageContains(err, kinesisanalyticsv2.ErrCodeInvalidArgumentException, "Kinesis Analytics doesn't have sufficient privileges") {
+			return resource.RetryableError(err)
+		}
+
+		// InvalidArgumentException: Given IAM role arn : arn:aws:iam::123456789012:role/xxx does not provide Invoke permissions on the Lambda resource : arn:aws:lambda:us-west-2:123456789012:function:yyy
+		if tfawserr.ErrMessageContains(err, kinesisanalyticsv2.ErrCodeInvalidArgumentException, "does not provide Invoke permissions on the Lambda resource") {
+			return resource.RetryableError(err)
+		}
+
+		// S3: InvalidArgumentException: Please check the role provided or validity of S3 location you provided. We are unable to get the specified fileKey: ...
+		if tfawserr.ErrMessageContains(err, kinesisanalyticsv2.ErrCodeInvalidArgumentException, "Please check the role provided or validity of S3 location you provided") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if isResourceTimeoutError(err) {
+		output, err = f()
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func expandKinesisAnalyticsV2ApplicationConfiguration(vApplicationConfiguration []interface{}) *kinesisanalyticsv2.ApplicationConfiguration {
+	if len(vApplicationConfiguration) == 0 || vApplicationConfiguration[0] == nil {
+		return nil
+	}
+
+	applicationConfiguration := &kinesisanalyticsv2.ApplicationConfiguration{}
+
+	mApplicationConfiguration := vApplicationConfiguration[0].(map[string]interface{})
+
+	if v, ok := mApplicationConfiguration["application_code_configuration"].([]interface{}); ok {
+		applicationConfiguration.ApplicationCodeConfiguration = expandKinesisAnalyticsV2ApplicationCodeConfiguration(v)
+	}
+
+	if v, ok := mApplicationConfiguration["application_snapshot_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		applicationConfiguration.ApplicationSnapshotConfiguration = &kinesisanalyticsv2.ApplicationSnapshotConfiguration{
+			SnapshotsEnabled: aws.Bool(v[0].(map[string]interface{})["snapshots_enabled"].(bool)),
+		}
+	}
+
+	if v, ok := mApplicationConfiguration["environment_properties"].([]interface{}); ok {
+		applicationConfiguration.EnvironmentProperties = expandKinesisAnalyticsV2EnvironmentProperties(v)
+	}
+
+	if v, ok := mApplicationConfiguration["flink_application_configuration"].([]interface{}); ok {
+		applicationConfiguration.FlinkApplicationConfiguration = expandKinesisAnalyticsV2FlinkApplicationConfiguration(v)
+	}
+
+	if v, ok := mApplicationConfiguration["sql_application_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		sqlApplicationConfiguration := &kinesisanalyticsv2.SqlApplicationConfiguration{}
+
+		mSqlApplicationConfiguration := v[0].(map[string]interface{})
+
+		if v, ok := mSqlApplicationConfiguration["input"].([]interface{}); ok && len(v) > 0 {
+			sqlApplicationConfiguration.Inputs = []*kinesisanalyticsv2.Input{expandKinesisAnalyticsV2Input(v)}
+		}
+
+		if v, ok := mSqlApplicationConfiguration["output"].([]interface{}); ok {
+			for _, vOutput := range v {
+				if output := expandKinesisAnalyticsV2Output(vOutput); output != nil {
+					sqlApplicationConfiguration.Outputs = append(sqlApplicationConfiguration.Outputs, output)
+				}
+			}
+		}
+
+		if v, ok := mSqlApplicationConfiguration["reference_data_source"].([]interface{}); ok && len(v) > 0 {
+			sqlApplicationConfiguration.ReferenceDataSources = []*kinesisanalyticsv2.ReferenceDataSource{expandKinesisAnalyticsV2ReferenceDataSource(v)}
+		}
+
+		applicationConfiguration.SqlApplicationConfiguration = sqlApplicationConfiguration
+	}
+
+	if v, ok := mApplicationConfiguration["vpc_configuration"].([]interface{}); ok && len(v) > 0 {
+		applicationConfiguration.VpcConfigurations = []*kinesisanalyticsv2.VpcConfiguration{expandKinesisAnalyticsV2VpcConfiguration(v)}
+	}
+
+	return applicationConfiguration
+}
+
+func expandKinesisAnalyticsV2ApplicationCodeConfiguration(vApplicationCodeConfiguration []interface{}) *kinesisanalyticsv2.ApplicationCodeConfiguration {
+	if len(vApplicationCodeConfiguration) == 0 || vApplicationCodeConfiguration[0] == nil {
+		return nil
+	}
+
+	applicationCodeConfiguration := &kinesisanalyticsv2.ApplicationCodeConfiguration{}
+
+	mApplicationCodeConfiguration := vApplicationCodeConfiguration[0].(map[string]interface{})
+
+	if v, ok := mApplicationCodeConfiguration["code_content"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		codeContent := &kinesisanalyticsv2.CodeContent{}
+
+		mCodeContent := v[0].(map[string]interface{})
+
+		if v, ok := mCodeContent["s3_content_location"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			s3ContentLocation := &kinesisanalyticsv2.S3ContentLocation{}
+
+			mS3ContentLocation := v[0].(map[string]interface{})
+
+			if v, ok := mS3ContentLocation["bucket_arn"].(string); ok && v != "" {
+				s3ContentLocation.BucketARN = aws.String(v)
+			}
+
+			if v, ok := mS3ContentLocation["file_key"].(string); ok && v != "" {
+				s3ContentLocation.FileKey = aws.String(v)
+			}
+
+			if v, ok := mS3ContentLocation["object_version"].(string); ok && v != "" {
+				s3ContentLocation.ObjectVersion = aws.String(v)
+			}
+
+			codeContent.S3ContentLocation = s3ContentLocation
+		}
+
+		if v, ok := mCodeContent["text_content"].(string); ok && v != "" {
+			codeContent.TextContent = aws.String(v)
+		}
+
+		applicationCodeConfiguration.CodeContent = codeContent
+	}
+
+	if v, ok := mApplicationCodeConfiguration["code_content_type"].(string); ok && v != "" {
+		applicationCodeConfiguration.CodeContentType = aws.String(v)
+	}
+
+	return applicationCodeConfiguration
+}
+
+func expandKinesisAnalyticsV2ApplicationCodeConfigurationUpdate(vApplicationCodeConfiguration []interface{}) *kinesisanalyticsv2.ApplicationCodeConfigurationUpdate {
+	applicationCodeConfiguration := expandKinesisAnalyticsV2ApplicationCodeConfiguration(vApplicationCodeConfiguration)
+
+	if applicationCodeConfiguration == nil {
+		return nil
+	}
+
+	applicationCodeConfigurationUpdate := &kinesisanalyticsv2.ApplicationCodeConfigurationUpdate{
+		CodeContentTypeUpdate: applicationCodeConfiguration.CodeContentType,
+	}
+
+	if codeContent := applicationCodeConfiguration.CodeContent; codeContent != nil {
+		codeContentUpdate := &kinesisanalyticsv2.CodeContentUpdate{
+			TextContentUpdate: codeContent.TextContent,
+		}
+
+		if s3ContentLocation := codeContent.S3ContentLocation; s3ContentLocation != nil {
+			codeContentUpdate.S3ContentLocationUpdate = &kinesisanalyticsv2.S3ContentLocationUpdate{
+				BucketARNUpdate:     s3ContentLocation.BucketARN,
+				FileKeyUpdate:       s3ContentLocation.FileKey,
+				ObjectVersionUpdate: s3ContentLocation.ObjectVersion,
+			}
+		}
+
+		applicationCodeConfigurationUpdate.CodeContentUpdate = codeContentUpdate
+	}
+
+	return applicationCodeConfigurationUpdate
+}
+
+func expandKinesisAnalyticsV2ApplicationSnapshotConfigurationUpdate(vApplicationSnapshotConfiguration []interface{}) *kinesisanalyticsv2.ApplicationSnapshotConfigurationUpdate {
+	if len(vApplicationSnapshotConfiguration) == 0 || vApplicationSnapshotConfiguration[0] == nil {
+		return nil
+	}
+
+	return &kinesisanalyticsv2.ApplicationSnapshotConfigurationUpdate{
+		SnapshotsEnabledUpdate: aws.Bool(vApplicationSnapshotConfiguration[0].(map[string]interface{})["snapshots_enabled"].(bool)),
+	}
+}
+
+func expandKinesisAnalyticsV2CloudWatchLoggingOptions(vCloudWatchLoggingOptions []interface{}) []*kinesisanalyticsv2.CloudWatchLoggingOption {
+	if len(vCloudWatchLoggingOptions) == 0 || vCloudWatchLoggingOptions[0] == nil {
+		return nil
+	}
+
+	cloudWatchLoggingOption := &kinesisanalyticsv2.CloudWatchLoggingOption{}
+
+	mCloudWatchLoggingOption := vCloudWatchLoggingOptions[0].(map[string]interface{})
+
+	if v, ok := mCloudWatchLoggingOption["log_stream_arn"].(string); ok && v != "" {
+		cloudWatchLoggingOption.LogStreamARN = aws.String(v)
+	}
+
+	return []*kinesisanalyticsv2.CloudWatchLoggingOption{cloudWatchLoggingOption}
+}
+
+func expandKinesisAnalyticsV2EnvironmentProperties(vEnvironmentProperties []interface{}) *kinesisanalyticsv2.EnvironmentProperties {
+	if len(vEnvironmentProperties) == 0 || vEnvironmentProperties[0] == nil {
+		return nil
+	}
+
+	environmentProperties := &kinesisanalyticsv2.EnvironmentProperties{}
+
+	mEnvironmentProperties := vEnvironmentProperties[0].(map[string]interface{})
+
+	if v, ok := mEnvironmentProperties["property_group"].(*schema.Set); ok && v.Len() > 0 {
+		for _, vPropertyGroup := range v.List() {
+			mPropertyGroup, ok := vPropertyGroup.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			environmentProperties.PropertyGroups = append(environmentProperties.PropertyGroups, &kinesisanalyticsv2.PropertyGroup{
+				PropertyGroupId: aws.String(mPropertyGroup["property_group_id"].(string)),
+				PropertyMap:     stringMapToPointers(mPropertyGroup["property_map"].(map[string]interface{})),
+			})
+		}
+	}
+
+	return environmentProperties
+}
+
+func expandKinesisAnalyticsV2FlinkApplicationConfiguration(vFlinkApplicationConfiguration []interface{}) *kinesisanalyticsv2.FlinkApplicationConfiguration {
+	if len(vFlinkApplicationConfiguration) == 0 || vFlinkApplicationConfiguration[0] == nil {
+		return nil
+	}
+
+	flinkApplicationConfiguration := &kinesisanalyticsv2.FlinkApplicationConfiguration{}
+
+	mFlinkApplicationConfiguration := vFlinkApplicationConfiguration[0].(map[string]interface{})
+
+	if v, ok := mFlinkApplicationConfiguration["checkpoint_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		mCheckpointConfiguration := v[0].(map[string]interface{})
+
+		checkpointConfiguration := &kinesisanalyticsv2.CheckpointConfiguration{
+			ConfigurationType: aws.String(mCheckpointConfiguration["configuration_type"].(string)),
+		}
+
+		if aws.StringValue(checkpointConfiguration.ConfigurationType) == kinesisanalyticsv2.ConfigurationTypeCustom {
+			if v, ok := mCheckpointConfiguration["checkpoint_interval"].(int); ok && v > 0 {
+				checkpointConfiguration.CheckpointInterval = aws.Int64(int64(v))
+			}
+
+			if v, ok := mCheckpointConfiguration["checkpointing_enabled"].(bool); ok {
+				checkpointConfiguration.CheckpointingEnabled = aws.Bool(v)
+			}
+
+			if v, ok := mCheckpointConfiguration["min_pause_between_checkpoints"].(int); ok {
+				checkpointConfiguration.MinPauseBetweenCheckpoints = aws.Int64(int64(v))
+			}
+		}
+
+		flinkApplicationConfiguration.CheckpointConfiguration = checkpointConfiguration
+	}
+
+	if v, ok := mFlinkApplicationConfiguration["monitoring_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		mMonitoringConfiguration := v[0].(map[string]interface{})
+
+		monitoringConfiguration := &kinesisanalyticsv2.MonitoringConfiguration{
+			ConfigurationType: aws.String(mMonitoringConfiguration["configuration_type"].(string)),
+		}
+
+		if aws.StringValue(monitoringConfiguration.ConfigurationType) == kinesisanalyticsv2.ConfigurationTypeCustom {
+			if v, ok := mMonitoringConfiguration["log_level"].(string); ok && v != "" {
+				monitoringConfiguration.LogLevel = aws.String(v)
+			}
+
+			if v, ok := mMonitoringConfiguration["metrics_level"].(string); ok && v != "" {
+				monitoringConfiguration.MetricsLevel = aws.String(v)
+			}
+		}
+
+		flinkApplicationConfiguration.MonitoringConfiguration = monitoringConfiguration
+	}
+
+	if v, ok := mFlinkApplicationConfiguration["parallelism_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		mParallelismConfiguration := v[0].(map[string]interface{})
+
+		parallelismConfiguration := &kinesisanalyticsv2.ParallelismConfiguration{
+			ConfigurationType: aws.String(mParallelismConfiguration["configuration_type"].(string)),
+		}
+
+		if aws.StringValue(parallelismConfiguration.ConfigurationType) == kinesisanalyticsv2.ConfigurationTypeCustom {
+			if v, ok := mParallelismConfiguration["auto_scaling_enabled"].(bool); ok {
+				parallelismConfiguration.AutoScalingEnabled = aws.Bool(v)
+			}
+
+			if v, ok := mParallelismConfiguration["parallelism"].(int); ok && v > 0 {
+				parallelismConfiguration.Parallelism = aws.Int64(int64(v))
+			}
+
+			if v, ok := mParallelismConfiguration["parallelism_per_kpu"].(int); ok && v > 0 {
+				parallelismConfiguration.ParallelismPerKPU = aws.Int64(int64(v))
+			}
+		}
+
+		flinkApplicationConfiguration.ParallelismConfiguration = parallelismConfiguration
+	}
+
+	return flinkApplicationConfiguration
+}
+
+func expandKinesisAnalyticsV2FlinkApplicationConfigurationUpdate(vFlinkApplicationConfiguration []interface{}) *kinesisanalyticsv2.FlinkApplicationConfigurationUpdate {
+	flinkApplicationConfiguration := expandKinesisAnalyticsV2FlinkApplicationConfiguration(vFlinkApplicationConfiguration)
+
+	if flinkApplicationConfiguration == nil {
+		return nil
+	}
+
+	flinkApplicationConfigurationUpdate := &kinesisanalyticsv2.FlinkApplicationConfigurationUpdate{}
+
+	if checkpointConfiguration := flinkApplicationConfiguration.CheckpointConfiguration; checkpointConfiguration != nil {
+		flinkApplicationConfigurationUpdate.CheckpointConfigurationUpdate = &kinesisanalyticsv2.CheckpointConfigurationUpdate{
+			CheckpointIntervalUpdate:         checkpointConfiguration.CheckpointInterval,
+			CheckpointingEnabledUpdate:       checkpointConfiguration.CheckpointingEnabled,
+			ConfigurationTypeUpdate:          checkpointConfiguration.ConfigurationType,
+			MinPauseBetweenCheckpointsUpdate: checkpointConfiguration.MinPauseBetweenCheckpoints,
+		}
+	}
+
+	if monitoringConfiguration := flinkApplicationConfiguration.MonitoringConfiguration; monitoringConfiguration != nil {
+		flinkApplicationConfigurationUpdate.MonitoringConfigurationUpdate = &kinesisanalyticsv2.MonitoringConfigurationUpdate{
+			ConfigurationTypeUpdate: monitoringConfiguration.ConfigurationType,
+			LogLevelUpdate:          monitoringConfiguration.LogLevel,
+			MetricsLevelUpdate:      monitoringConfiguration.MetricsLevel,
+		}
+	}
+
+	if parallelismConfiguration := flinkApplicationConfiguration.ParallelismConfiguration; parallelismConfiguration != nil {
+		flinkApplicationConfigurationUpdate.ParallelismConfigurationUpdate = &kinesisanalyticsv2.ParallelismConfigurationUpdate{
+			AutoScalingEnabledUpdate: parallelismConfiguration.AutoScalingEnabled,
+			ConfigurationTypeUpdate:  parallelismConfiguration.ConfigurationType,
+			ParallelismPerKPUUpdate:  parallelismConfiguration.ParallelismPerKPU,
+			ParallelismUpdate:        parallelismConfiguration.Parallelism,
+		}
+	}
+
+	return flinkApplicationConfigurationUpdate
+}
+
+func expandKinesisAnalyticsV2Input(vInput []interface{}) *kinesisanalyticsv2.Input {
+	if len(vInput) == 0 || vInput[0] == nil {
+		return nil
+	}
+
+	input := &kinesisanalyticsv2.Input{}
+
+	mInput := vInput[0].(map[string]interface{})
+
+	if v, ok := mInput["input_parallelism"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		if v, ok := v[0].(map[string]interface{})["count"].(int); ok && v > 0 {
+			input.InputParallelism = &kinesisanalyticsv2.InputParallelism{
+				Count: aws.Int64(int64(v)),
+			}
+		}
+	}
+
+	if v, ok := mInput["input_processing_configuration"].([]interface{}); ok {
+		input.InputProcessingConfiguration = expandKinesisAnalyticsV2InputProcessingConfiguration(v)
+	}
+
+	if v, ok := mInput["input_schema"].([]interface{}); ok {
+		input.InputSchema = expandKinesisAnalyticsV2SourceSchema(v)
+	}
+
+	if v, ok := mInput["kinesis_firehose_input"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		input.KinesisFirehoseInput = &kinesisanalyticsv2.KinesisFirehoseInput{
+			ResourceARN: aws.String(v[0].(map[string]interface{})["resource_arn"].(string)),
+		}
+	}
+
+	if v, ok := mInput["kinesis_streams_input"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		input.KinesisStreamsInput = &kinesisanalyticsv2.KinesisStreamsInput{
+			ResourceARN: aws.String(v[0].(map[string]interface{})["resource_arn"].(string)),
+		}
+	}
+
+	if v, ok := mInput["name_prefix"].(string); ok && v != "" {
+		input.NamePrefix = aws.String(v)
+	}
+
+	return input
+}
+
+func expandKinesisAnalyticsV2InputUpdate(vInput []interface{}) *kinesisanalyticsv2.InputUpdate {
+	input := expandKinesisAnalyticsV2Input(vInput)
+
+	if input == nil {
+		return nil
+	}
+
+	inputUpdate := &kinesisanalyticsv2.InputUpdate{
+		NamePrefixUpdate: input.NamePrefix,
+	}
+
+	if inputParallelism := input.InputParallelism; inputParallelism != nil {
+		inputUpdate.InputParallelismUpdate = &kinesisanalyticsv2.InputParallelismUpdate{
+			CountUpdate: inputParallelism.Count,
+		}
+	}
+
+	if inputSchema := input.InputSchema; inputSchema != nil {
+		inputUpdate.InputSchemaUpdate = &kinesisanalyticsv2.InputSchemaUpdate{
+			RecordColumnUpdates:  inputSchema.RecordColumns,
+			RecordEncodingUpdate: inputSchema.RecordEncoding,
+			RecordFormatUpdate:   inputSchema.RecordFormat,
+		}
+	}
+
+	if kinesisFirehoseInput := input.KinesisFirehoseInput; kinesisFirehoseInput != nil {
+		inputUpdate.KinesisFirehoseInputUpdate = &kinesisanalyticsv2.KinesisFirehoseInputUpdate{
+			ResourceARNUpdate: kinesisFirehoseInput.ResourceARN,
+		}
+	}
+
+	if kinesisStreamsInput := input.KinesisStreamsInput; kinesisStreamsInput != nil {
+		inputUpdate.KinesisStreamsInputUpdate = &kinesisanalyticsv2.KinesisStreamsInputUpdate{
+			ResourceARNUpdate: kinesisStreamsInput.ResourceARN,
+		}
+	}
+
+	return inputUpdate
+}
+
+func expandKinesisAnalyticsV2InputProcessingConfiguration(vInputProcessingConfiguration []interface{}) *kinesisanalyticsv2.InputProcessingConfiguration {
+	if len(vInputProcessingConfiguration) == 0 || vInputProcessingConfiguration[0] == nil {
+		return nil
+	}
+
+	inputProcessingConfiguration := &kinesisanalyticsv2.InputProcessingConfiguration{}
+
+	mInputProcessingConfiguration := vInputProcessingConfiguration[0].(map[string]interface{})
+
+	if v, ok := mInputProcessingConfiguration["input_lambda_processor"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		inputProcessingConfiguration.InputLambdaProcessor = &kinesisanalyticsv2.InputLambdaProcessor{
+			ResourceARN: aws.String(v[0].(map[string]interface{})["resource_arn"].(string)),
+		}
+	}
+
+	return inputProcessingConfiguration
+}
+
+func expandKinesisAnalyticsV2InputProcessingConfigurationUpdate(vInputProcessingConfiguration []interface{}) *kinesisanalyticsv2.InputProcessingConfigurationUpdate {
+	inputProcessingConfiguration := expandKinesisAnalyticsV2InputProcessingConfiguration(vInputProcessingConfiguration)
+
+	if inputProcessingConfiguration == nil || inputProcessingConfiguration.InputLambdaProcessor == nil {
+		return nil
+	}
+
+	return &kinesisanalyticsv2.InputProcessingConfigurationUpdate{
+		InputLambdaProcessorUpdate: &kinesisanalyticsv2.InputLambdaProcessorUpdate{
+			ResourceARNUpdate: inputProcessingConfiguration.InputLambdaProcessor.ResourceARN,
+		},
+	}
+}
+
+func expandKinesisAnalyticsV2Output(vOutput interface{}) *kinesisanalyticsv2.Output {
+	mOutput, ok := vOutput.(map[string]interface{})
+
+	if !ok {
+		return nil
+	}
+
+	output := &kinesisanalyticsv2.Output{}
+
+	if v, ok := mOutput["destination_schema"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		output.DestinationSchema = &kinesisanalyticsv2.DestinationSchema{
+			RecordFormatType: aws.String(v[0].(map[string]interface{})["record_format_type"].(string)),
+		}
+	}
+
+	if v, ok := mOutput["kinesis_firehose_output"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		output.KinesisFirehoseOutput = &kinesisanalyticsv2.KinesisFirehoseOutput{
+			ResourceARN: aws.String(v[0].(map[string]interface{})["resource_arn"].(string)),
+		}
+	}
+
+	if v, ok := mOutput["kinesis_streams_output"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		output.KinesisStreamsOutput = &kinesisanalyticsv2.KinesisStreamsOutput{
+			ResourceARN: aws.String(v[0].(map[string]interface{})["resource_arn"].(string)),
+		}
+	}
+
+	if v, ok := mOutput["lambda_output"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		output.LambdaOutput = &kinesisanalyticsv2.LambdaOutput{
+			ResourceARN: aws.String(v[0].(map[string]interface{})["resource_arn"].(string)),
+		}
+	}
+
+	if v, ok := mOutput["name"].(string); ok && v != "" {
+		output.Name = aws.String(v)
+	}
+
+	return output
+}
+
+func expandKinesisAnalyticsV2OutputUpdate(vOutput interface{}) *kinesisanalyticsv2.OutputUpdate {
+	output := expandKinesisAnalyticsV2Output(vOutput)
+
+	if output == nil {
+		return nil
+	}
+
+	outputUpdate := &kinesisanalyticsv2.OutputUpdate{
+		DestinationSchemaUpdate: output.DestinationSchema,
+		NameUpdate:              output.Name,
+	}
+
+	if kinesisFirehoseOutput := output.KinesisFirehoseOutput; kinesisFirehoseOutput != nil {
+		outputUpdate.KinesisFirehoseOutputUpdate = &kinesisanalyticsv2.KinesisFirehoseOutputUpdate{
+			ResourceARNUpdate: kinesisFirehoseOutput.ResourceARN,
+		}
+	}
+
+	if kinesisStreamsOutput := output.KinesisStreamsOutput; kinesisStreamsOutput != nil {
+		outputUpdate.KinesisStreamsOutputUpdate = &kinesisanalyticsv2.KinesisStreamsOutputUpdate{
+			ResourceARNUpdate: kinesisStreamsOutput.ResourceARN,
+		}
+	}
+
+	if lambdaOutput := output.LambdaOutput; lambdaOutput != nil {
+		outputUpdate.LambdaOutputUpdate = &kinesisanalyticsv2.LambdaOutputUpdate{
+			ResourceARNUpdate: lambdaOutput.ResourceARN,
+		}
+	}
+
+	return outputUpdate
+}
+
+func expandKinesisAnalyticsV2ReferenceDataSource(vReferenceDataSource []interface{}) *kinesisanalyticsv2.ReferenceDataSource {
+	if len(vReferenceDataSource) == 0 || vReferenceDataSource[0] == nil {
+		return nil
+	}
+
+	referenceDataSource := &kinesisanalyticsv2.ReferenceDataSource{}
+
+	mReferenceDataSource := vReferenceDataSource[0].(map[string]interface{})
+
+	if v, ok := mReferenceDataSource["reference_schema"].([]interface{}); ok {
+		referenceDataSource.ReferenceSchema = expandKinesisAnalyticsV2SourceSchema(v)
+	}
+
+	if v, ok := mReferenceDataSource["s3_reference_data_source"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		mS3ReferenceDataSource := v[0].(map[string]interface{})
+
+		referenceDataSource.S3ReferenceDataSource = &kinesisanalyticsv2.S3ReferenceDataSource{
+			BucketARN: aws.String(mS3ReferenceDataSource["bucket_arn"].(string)),
+			FileKey:   aws.String(mS3ReferenceDataSource["file_key"].(string)),
+		}
+	}
+
+	if v, ok := mReferenceDataSource["table_name"].(string); ok && v != "" {
+		referenceDataSource.TableName = aws.String(v)
+	}
+
+	return referenceDataSource
+}
+
+func expandKinesisAnalyticsV2RunConfiguration(vRunConfiguration []interface{}) *kinesisanalyticsv2.RunConfiguration {
+	if len(vRunConfiguration) == 0 || vRunConfiguration[0] == nil {
+		return nil
+	}
+
+	runConfiguration := &kinesisanalyticsv2.RunConfiguration{}
+
+	mRunConfiguration := vRunConfiguration[0].(map[string]interface{})
+
+	if v, ok := mRunConfiguration["application_restore_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		applicationRestoreConfiguration := &kinesisanalyticsv2.ApplicationRestoreConfiguration{}
+
+		mApplicationRestoreConfiguration := v[0].(map[string]interface{})
+
+		if v, ok := mApplicationRestoreConfiguration["application_restore_type"].(string); ok && v != "" {
+			applicationRestoreConfiguration.ApplicationRestoreType = aws.String(v)
+		}
+
+		if v, ok := mApplicationRestoreConfiguration["snapshot_name"].(string); ok && v != "" {
+			applicationRestoreConfiguration.SnapshotName = aws.String(v)
+		}
+
+		if applicationRestoreConfiguration.ApplicationRestoreType != nil {
+			runConfiguration.ApplicationRestoreConfiguration = applicationRestoreConfiguration
+		}
+	}
+
+	if v, ok := mRunConfiguration["flink_run_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		runConfiguration.FlinkRunConfiguration = &kinesisanalyticsv2.FlinkRunConfiguration{
+			AllowNonRestoredState: aws.Bool(v[0].(map[string]interface{})["allow_non_restored_state"].(bool)),
+		}
+	}
+
+	return runConfiguration
+}
+
+func expandKinesisAnalyticsV2RunConfigurationUpdate(vRunConfiguration []interface{}) *kinesisanalyticsv2.RunConfigurationUpdate {
+	runConfiguration := expandKinesisAnalyticsV2RunConfiguration(vRunConfiguration)
+
+	if runConfiguration == nil {
+		return nil
+	}
+
+	return &kinesisanalyticsv2.RunConfigurationUpdate{
+		ApplicationRestoreConfiguration: runConfiguration.ApplicationRestoreConfiguration,
+		FlinkRunConfiguration:           runConfiguration.FlinkRunConfiguration,
+	}
+}
+
+func expandKinesisAnalyticsV2SourceSchema(vSourceSchema []interface{}) *kinesisanalyticsv2.SourceSchema {
+	if len(vSourceSchema) == 0 || vSourceSchema[0] == nil {
+		return nil
+	}
+
+	sourceSchema := &kinesisanalyticsv2.SourceSchema{}
+
+	mSourceSchema := vSourceSchema[0].(map[string]interface{})
+
+	if v, ok := mSourceSchema["record_column"].([]interface{}); ok {
+		for _, vRecordColumn := range v {
+			mRecordColumn, ok := vRecordColumn.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			recordColumn := &kinesisanalyticsv2.RecordColumn{
+				Name:    aws.String(mRecordColumn["name"].(string)),
+				SqlType: aws.String(mRecordColumn["sql_type"].(string)),
+			}
+
+			if v, ok := mRecordColumn["mapping"].(string); ok && v != "" {
+				recordColumn.Mapping = aws.String(v)
+			}
+
+			sourceSchema.RecordColumns = append(sourceSchema.RecordColumns, recordColumn)
+		}
+	}
+
+	if v, ok := mSourceSchema["record_encoding"].(string); ok && v != "" {
+		sourceSchema.RecordEncoding = aws.String(v)
+	}
+
+	if v, ok := mSourceSchema["record_format"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		mRecordFormat := v[0].(map[string]interface{})
+
+		recordFormat := &kinesisanalyticsv2.RecordFormat{
+			RecordFormatType: aws.String(mRecordFormat["record_format_type"].(string)),
+		}
+
+		if v, ok := mRecordFormat["mapping_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			mappingParameters := &kinesisanalyticsv2.MappingParameters{}
+
+			mMappingParameters := v[0].(map[string]interface{})
+
+			if v, ok := mMappingParameters["csv_mapping_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				mCsvMappingParameters := v[0].(map[string]interface{})
+
+				mappingParameters.CSVMappingParameters = &kinesisanalyticsv2.CSVMappingParameters{
+					RecordColumnDelimiter: aws.String(mCsvMappingParameters["record_column_delimiter"].(string)),
+					RecordRowDelimiter:    aws.String(mCsvMappingParameters["record_row_delimiter"].(string)),
+				}
+			}
+
+			if v, ok := mMappingParameters["json_mapping_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				mappingParameters.JSONMappingParameters = &kinesisanalyticsv2.JSONMappingParameters{
+					RecordRowPath: aws.String(v[0].(map[string]interface{})["record_row_path"].(string)),
+				}
+			}
+
+			recordFormat.MappingParameters = mappingParameters
+		}
+
+		sourceSchema.RecordFormat = recordFormat
+	}
+
+	return sourceSchema
+}
+
+func expandKinesisAnalyticsV2VpcConfiguration(vVpcConfiguration []interface{}) *kinesisanalyticsv2.VpcConfiguration {
+	if len(vVpcConfiguration) == 0 || vVpcConfiguration[0] == nil {
+		return nil
+	}
+
+	vpcConfiguration := &kinesisanalyticsv2.VpcConfiguration{}
+
+	mVpcConfiguration := vVpcConfiguration[0].(map[string]interface{})
+
+	if v, ok := mVpcConfiguration["security_group_ids"].(*schema.Set); ok && v.Len() > 0 {
+		vpcConfiguration.SecurityGroupIds = expandStringSet(v)
+	}
+
+	if v, ok := mVpcConfiguration["subnet_ids"].(*schema.Set); ok && v.Len() > 0 {
+		vpcConfiguration.SubnetIds = expandStringSet(v)
+	}
+
+	return vpcConfiguration
+}
+
+func flattenKinesisAnalyticsV2ApplicationConfigurationDescription(applicationConfigurationDescription *kinesisanalyticsv2.ApplicationConfigurationDescription) []interface{} {
+	if applicationConfigurationDescription == nil {
+		return []interface{}{}
+	}
+
+	mApplicationConfiguration := map[string]interface{}{}
+
+	if applicationCodeConfigurationDescription := applicationConfigurationDescription.ApplicationCodeConfigurationDescription; applicationCodeConfigurationDescription != nil {
+		mApplicationCodeConfiguration := map[string]interface{}{
+			"code_content_type": aws.StringValue(applicationCodeConfigurationDescription.CodeContentType),
+		}
+
+		if codeContentDescription := applicationCodeConfigurationDescription.CodeContentDescription; codeContentDescription != nil {
+			mCodeContent := map[string]interface{}{
+				"text_content": aws.StringValue(codeContentDescription.TextContent),
+			}
+
+			if s3ApplicationCodeLocationDescription := codeContentDescription.S3ApplicationCodeLocationDescription; s3ApplicationCodeLocationDescription != nil {
+				mS3ContentLocation := map[string]interface{}{
+					"bucket_arn":     aws.StringValue(s3ApplicationCodeLocationDescription.BucketARN),
+					"file_key":       aws.StringValue(s3ApplicationCodeLocationDescription.FileKey),
+					"object_version": aws.StringValue(s3ApplicationCodeLocationDescription.ObjectVersion),
+				}
+
+				mCodeContent["s3_content_location"] = []interface{}{mS3ContentLocation}
+			}
+
+			mApplicationCodeConfiguration["code_content"] = []interface{}{mCodeContent}
+		}
+
+		mApplicationConfiguration["application_code_configuration"] = []interface{}{mApplicationCodeConfiguration}
+	}
+
+	if applicationSnapshotConfigurationDescription := applicationConfigurationDescription.ApplicationSnapshotConfigurationDescription; applicationSnapshotConfigurationDescription != nil {
+		mApplicationSnapshotConfiguration := map[string]interface{}{
+			"snapshots_enabled": aws.BoolValue(applicationSnapshotConfigurationDescription.SnapshotsEnabled),
+		}
+
+		mApplicationConfiguration["application_snapshot_configuration"] = []interface{}{mApplicationSnapshotConfiguration}
+	}
+
+	if environmentPropertyDescriptions := applicationConfigurationDescription.EnvironmentPropertyDescriptions; environmentPropertyDescriptions != nil && len(environmentPropertyDescriptions.PropertyGroupDescriptions) > 0 {
+		vPropertyGroups := []interface{}{}
+
+		for _, propertyGroup := range environmentPropertyDescriptions.PropertyGroupDescriptions {
+			if propertyGroup == nil {
+				continue
+			}
+
+			mPropertyGroup := map[string]interface{}{
+				"property_group_id": aws.StringValue(propertyGroup.PropertyGroupId),
+				"property_map":      pointersMapToStringList(propertyGroup.PropertyMap),
+			}
+
+			vPropertyGroups = append(vPropertyGroups, mPropertyGroup)
+		}
+
+		mApplicationConfiguration["environment_properties"] = []interface{}{
+			map[string]interface{}{
+				"property_group": vPropertyGroups,
+			},
+		}
+	}
+
+	if flinkApplicationConfigurationDescription := applicationConfigurationDescription.FlinkApplicationConfigurationDescription; flinkApplicationConfigurationDescription != nil {
+		mFlinkApplicationConfiguration := map[string]interface{}{}
+
+		if checkpointConfigurationDescription := flinkApplicationConfigurationDescription.CheckpointConfigurationDescription; checkpointConfigurationDescription != nil {
+			mCheckpointConfiguration := map[string]interface{}{
+				"checkpoint_interval":           int(aws.Int64Value(checkpointConfigurationDescription.CheckpointInterval)),
+				"checkpointing_enabled":         aws.BoolValue(checkpointConfigurationDescription.CheckpointingEnabled),
+				"configuration_type":            aws.StringValue(checkpointConfigurationDescription.ConfigurationType),
+				"min_pause_between_checkpoints": int(aws.Int64Value(checkpointConfigurationDescription.MinPauseBetweenCheckpoints)),
+			}
+
+			mFlinkApplicationConfiguration["checkpoint_configuration"] = []interface{}{mCheckpointConfiguration}
+		}
+
+		if monitoringConfigurationDescription := flinkApplicationConfigurationDescription.MonitoringConfigurationDescription; monitoringConfigurationDescription != nil {
+			mMonitoringConfiguration := map[string]interface{}{
+				"configuration_type": aws.StringValue(monitoringConfigurationDescription.ConfigurationType),
+				"log_level":          aws.StringValue(monitoringConfigurationDescription.LogLevel),
+				"metrics_level":      aws.StringValue(monitoringConfigurationDescription.MetricsLevel),
+			}
+
+			mFlinkApplicationConfiguration["monitoring_configuration"] = []interface{}{mMonitoringConfiguration}
+		}
+
+		if parallelismConfigurationDescription := flinkApplicationConfigurationDescription.ParallelismConfigurationDescription; parallelismConfigurationDescription != nil {
+			mParallelismConfiguration := map[string]interface{}{
+				"auto_scaling_enabled": aws.BoolValue(parallelismConfigurationDescription.AutoScalingEnabled),
+				"configuration_type":   aws.StringValue(parallelismConfigurationDescription.ConfigurationType),
+				"parallelism":          int(aws.Int64Value(parallelismConfigurationDescription.Parallelism)),
+				"parallelism_per_kpu":  int(aws.Int64Value(parallelismConfigurationDescription.ParallelismPerKPU)),
+			}
+
+			mFlinkApplicationConfiguration["parallelism_configuration"] = []interface{}{mParallelismConfiguration}
+		}
+
+		mApplicationConfiguration["flink_application_configuration"] = []interface{}{mFlinkApplicationConfiguration}
+	}
+
+	if runConfigurationDescription := applicationConfigurationDescription.RunConfigurationDescription; runConfigurationDescription != nil {
+		mRunConfiguration := map[string]interface{}{}
+
+		if applicationRestoreConfigurationDescription := runConfigurationDescription.ApplicationRestoreConfigurationDescription; applicationRestoreConfigurationDescription != nil {
+			mApplicationRestoreConfiguration := map[string]interface{}{
+				"application_restore_type": aws.StringValue(applicationRestoreConfigurationDescription.ApplicationRestoreType),
+				"snapshot_name":            aws.StringValue(applicationRestoreConfigurationDescription.SnapshotName),
+			}
+
+			mRunConfiguration["application_restore_configuration"] = []interface{}{mApplicationRestoreConfiguration}
+		}
+
+		if flinkRunConfigurationDescription := runConfigurationDescription.FlinkRunConfigurationDescription; flinkRunConfigurationDescription != nil {
+			mFlinkRunConfiguration := map[string]interface{}{
+				"allow_non_restored_state": aws.BoolValue(flinkRunConfigurationDescription.AllowNonRestoredState),
+			}
+
+			mRunConfiguration["flink_run_configuration"] = []interface{}{mFlinkRunConfiguration}
+		}
+
+		mApplicationConfiguration["run_configuration"] = []interface{}{mRunConfiguration}
+	}
+
+	if sqlApplicationConfigurationDescription := applicationConfigurationDescription.SqlApplicationConfigurationDescription; sqlApplicationConfigurationDescription != nil {
+		mSqlApplicationConfiguration := map[string]interface{}{}
+
+		if len(sqlApplicationConfigurationDescription.InputDescriptions) > 0 && sqlApplicationConfigurationDescription.InputDescriptions[0] != nil {
+			inputDescription := sqlApplicationConfigurationDescription.InputDescriptions[0]
+
+			mInput := map[string]interface{}{
+				"in_app_stream_names": flattenStringList(inputDescription.InAppStreamNames),
+				"input_id":            aws.StringValue(inputDescription.InputId),
+				"input_schema":        flattenKinesisAnalyticsV2SourceSchema(inputDescription.InputSchema),
+				"name_prefix":         aws.StringValue(inputDescription.NamePrefix),
+			}
+
+			if inputParallelism := inputDescription.InputParallelism; inputParallelism != nil {
+				mInput["input_parallelism"] = []interface{}{
+					map[string]interface{}{
+						"count": int(aws.Int64Value(inputParallelism.Count)),
+					},
+				}
+			}
+
+			if inputProcessingConfigurationDescription := inputDescription.InputProcessingConfigurationDescription; inputProcessingConfigurationDescription != nil && inputProcessingConfigurationDescription.InputLambdaProcessorDescription != nil {
+				mInput["input_processing_configuration"] = []interface{}{
+					map[string]interface{}{
+						"input_lambda_processor": []interface{}{
+							map[string]interface{}{
+								"resource_arn": aws.StringValue(inputProcessingConfigurationDescription.InputLambdaProcessorDescription.ResourceARN),
+							},
+						},
+					},
+				}
+			}
+
+			if inputStartingPositionConfiguration := inputDescription.InputStartingPositionConfiguration; inputStartingPositionConfiguration != nil {
+				mInput["input_starting_position_configuration"] = []interface{}{
+					map[string]interface{}{
+						"input_starting_position": aws.StringValue(inputStartingPositionConfiguration.InputStartingPosition),
+					},
+				}
+			}
+
+			if kinesisFirehoseInputDescription := inputDescription.KinesisFirehoseInputDescription; kinesisFirehoseInputDescription != nil {
+				mInput["kinesis_firehose_input"] = []interface{}{
+					map[string]interface{}{
+						"resource_arn": aws.StringValue(kinesisFirehoseInputDescription.ResourceARN),
+					},
+				}
+			}
+
+			if kinesisStreamsInputDescription := inputDescription.KinesisStreamsInputDescription; kinesisStreamsInputDescription != nil {
+				mInput["kinesis_streams_input"] = []interface{}{
+					map[string]interface{}{
+						"resource_arn": aws.StringValue(kinesisStreamsInputDescription.ResourceARN),
+					},
+				}
+			}
+
+			mSqlApplicationConfiguration["input"] = []interface{}{mInput}
+		}
+
+		if len(sqlApplicationConfigurationDescription.OutputDescriptions) > 0 {
+			vOutputs := []interface{}{}
+
+			for _, outputDescription := range sqlApplicationConfigurationDescription.OutputDescriptions {
+				if outputDescription == nil {
+					continue
+				}
+
+				mOutput := map[string]interface{}{
+					"name":      aws.StringValue(outputDescription.Name),
+					"output_id": aws.StringValue(outputDescription.OutputId),
+				}
+
+				if destinationSchema := outputDescription.DestinationSchema; destinationSchema != nil {
+					mOutput["destination_schema"] = []interface{}{
+						map[string]interface{}{
+							"record_format_type": aws.StringValue(destinationSchema.RecordFormatType),
+						},
+					}
+				}
+
+				if kinesisFirehoseOutputDescription := outputDescription.KinesisFirehoseOutputDescription; kinesisFirehoseOutputDescription != nil {
+					mOutput["kinesis_firehose_output"] = []interface{}{
+						map[string]interface{}{
+							"resource_arn": aws.StringValue(kinesisFirehoseOutputDescription.ResourceARN),
+						},
+					}
+				}
+
+				if kinesisStreamsOutputDescription := outputDescription.KinesisStreamsOutputDescription; kinesisStreamsOutputDescription != nil {
+					mOutput["kinesis_streams_output"] = []interface{}{
+						map[string]interface{}{
+							"resource_arn": aws.StringValue(kinesisStreamsOutputDescription.ResourceARN),
+						},
+					}
+				}
+
+				if lambdaOutputDescription := outputDescription.LambdaOutputDescription; lambdaOutputDescription != nil {
+					mOutput["lambda_output"] = []interface{}{
+						map[string]interface{}{
+							"resource_arn": aws.StringValue(lambdaOutputDescription.ResourceARN),
+						},
+					}
+				}
+
+				vOutputs = append(vOutputs, mOutput)
+			}
+
+			mSqlApplicationConfiguration["output"] = vOutputs
+		}
+
+		if len(sqlApplicationConfigurationDescription.ReferenceDataSourceDescriptions) > 0 && sqlApplicationConfigurationDescription.ReferenceDataSourceDescriptions[0] != nil {
+			referenceDataSourceDescription := sqlApplicationConfigurationDescription.ReferenceDataSourceDescriptions[0]
+
+			mReferenceDataSource := map[string]interface{}{
+				"reference_id":     aws.StringValue(referenceDataSourceDescription.ReferenceId),
+				"reference_schema": flattenKinesisAnalyticsV2SourceSchema(referenceDataSourceDescription.ReferenceSchema),
+				"table_name":       aws.StringValue(referenceDataSourceDescription.TableName),
+			}
+
+			if s3ReferenceDataSourceDescription := referenceDataSourceDescription.S3ReferenceDataSourceDescription; s3ReferenceDataSourceDescription != nil {
+				mReferenceDataSource["s3_reference_data_source"] = []interface{}{
+					map[string]interface{}{
+						"bucket_arn": aws.StringValue(s3ReferenceDataSourceDescription.BucketARN),
+						"file_key":   aws.StringValue(s3ReferenceDataSourceDescription.FileKey),
+					},
+				}
+			}
+
+			mSqlApplicationConfiguration["reference_data_source"] = []interface{}{mReferenceDataSource}
+		}
+
+		if len(mSqlApplicationConfiguration) > 0 {
+			mApplicationConfiguration["sql_application_configuration"] = []interface{}{mSqlApplicationConfiguration}
+		}
+	}
+
+	if len(applicationConfigurationDescription.VpcConfigurationDescriptions) > 0 && applicationConfigurationDescription.VpcConfigurationDescriptions[0] != nil {
+		vpcConfigurationDescription := applicationConfigurationDescription.VpcConfigurationDescriptions[0]
+
+		mVpcConfiguration := map[string]interface{}{
+			"security_group_ids":   flattenStringSet(vpcConfigurationDescription.SecurityGroupIds),
+			"subnet_ids":           flattenStringSet(vpcConfigurationDescription.SubnetIds),
+			"vpc_configuration_id": aws.StringValue(vpcConfigurationDescription.VpcConfigurationId),
+			"vpc_id":               aws.StringValue(vpcConfigurationDescription.VpcId),
+		}
+
+		mApplicationConfiguration["vpc_configuration"] = []interface{}{mVpcConfiguration}
+	}
+
+	return []interface{}{mApplicationConfiguration}
+}
+
+func flattenKinesisAnalyticsV2CloudWatchLoggingOptionDescriptions(cloudWatchLoggingOptionDescriptions []*kinesisanalyticsv2.CloudWatchLoggingOptionDescription) []interface{} {
+	if len(cloudWatchLoggingOptionDescriptions) == 0 || cloudWatchLoggingOptionDescriptions[0] == nil {
+		return []interface{}{}
+	}
+
+	cloudWatchLoggingOptionDescription := cloudWatchLoggingOptionDescriptions[0]
+
+	mCloudWatchLoggingOption := map[string]interface{}{
+		"cloudwatch_logging_option_id": aws.StringValue(cloudWatchLoggingOptionDescription.CloudWatchLoggingOptionId),
+		"log_stream_arn":               aws.StringValue(cloudWatchLoggingOptionDescription.LogStreamARN),
+	}
+
+	return []interface{}{mCloudWatchLoggingOption}
+}
+
+func flattenKinesisAnalyticsV2SourceSchema(sourceSchema *kinesisanalyticsv2.SourceSchema) []interface{} {
+	if sourceSchema == nil {
+		return []interface{}{}
+	}
+
+	mSourceSchema := map[string]interface{}{
+		"record_encoding": aws.StringValue(sourceSchema.RecordEncoding),
+	}
+
+	if len(sourceSchema.RecordColumns) > 0 {
+		vRecordColumns := []interface{}{}
+
+		for _, recordColumn := range sourceSchema.RecordColumns {
+			if recordColumn == nil {
+				continue
+			}
+
+			mRecordColumn := map[string]interface{}{
+				"mapping":  aws.StringValue(recordColumn.Mapping),
+				"name":     aws.StringValue(recordColumn.Name),
+				"sql_type": aws.StringValue(recordColumn.SqlType),
+			}
+
+			vRecordColumns = append(vRecordColumns, mRecordColumn)
+		}
+
+		mSourceSchema["record_column"] = vRecordColumns
+	}
+
+	if recordFormat := sourceSchema.RecordFormat; recordFormat != nil {
+		mRecordFormat := map[string]interface{}{
+			"record_format_type": aws.StringValue(recordFormat.RecordFormatType),
+		}
+
+		if mappingParameters := recordFormat.MappingParameters; mappingParameters != nil {
+			mMappingParameters := map[string]interface{}{}
+
+			if csvMappingParameters := mappingParameters.CSVMappingParameters; csvMappingParameters != nil {
+				mMappingParameters["csv_mapping_parameters"] = []interface{}{
+					map[string]interface{}{
+						"record_column_delimiter": aws.StringValue(csvMappingParameters.RecordColumnDelimiter),
+						"record_row_delimiter":    aws.StringValue(csvMappingParameters.RecordRowDelimiter),
+					},
+				}
+			}
+
+			if jsonMappingParameters := mappingParameters.JSONMappingParameters; jsonMappingParameters != nil {
+				mMappingParameters["json_mapping_parameters"] = []interface{}{
+					map[string]interface{}{
+						"record_row_path": aws.StringValue(jsonMappingParameters.RecordRowPath),
+					},
+				}
+			}
+
+			mRecordFormat["mapping_parameters"] = []interface{}{mMappingParameters}
+		}
+
+		mSourceSchema["record_format"] = []interface{}{mRecordFormat}
+	}
+
+	return []interface{}{mSourceSchema}
+}
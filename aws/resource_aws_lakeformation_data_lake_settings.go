@@ -0,0 +1,270 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAwsLakeFormationDataLakeSettings() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLakeFormationDataLakeSettingsCreate,
+		Update: resourceAwsLakeFormationDataLakeSettingsCreate,
+		Read:   resourceAwsLakeFormationDataLakeSettingsRead,
+		Delete: resourceAwsLakeFormationDataLakeSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"admins": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateArn,
+				},
+			},
+			"catalog_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"create_database_default_permissions": lakeFormationPrincipalPermissionsSchema(),
+			"create_table_default_permissions":    lakeFormationPrincipalPermissionsSchema(),
+			"trusted_resource_owners": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateAwsAccountId,
+				},
+			},
+		},
+	}
+}
+
+func lakeFormationPrincipalPermissionsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"permissions": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Computed: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringInSlice(lakeformation.Permission_Values(), false),
+					},
+				},
+				"principal": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validateLakeFormationPrincipal,
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsLakeFormationDataLakeSettingsCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lakeformationconn
+
+	catalogID := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("catalog_id"); ok {
+		catalogID = v.(string)
+	}
+
+	settings := &lakeformation.DataLakeSettings{
+		CreateDatabaseDefaultPermissions: expandLakeFormationPrincipalPermissions(d.Get("create_database_default_permissions").([]interface{})),
+		CreateTableDefaultPermissions:    expandLakeFormationPrincipalPermissions(d.Get("create_table_default_permissions").([]interface{})),
+		DataLakeAdmins:                   expandLakeFormationDataLakePrincipals(d.Get("admins").(*schema.Set)),
+		TrustedResourceOwners:            expandStringList(d.Get("trusted_resource_owners").([]interface{})),
+	}
+
+	input := &lakeformation.PutDataLakeSettingsInput{
+		CatalogId:        aws.String(catalogID),
+		DataLakeSettings: settings,
+	}
+
+	log.Printf("[DEBUG] Putting Lake Formation Data Lake Settings: %s", input)
+	_, err := conn.PutDataLakeSettings(input)
+
+	if err != nil {
+		return fmt.Errorf("error putting Lake Formation Data Lake Settings (%s): %w", catalogID, err)
+	}
+
+	d.SetId(catalogID)
+
+	return resourceAwsLakeFormationDataLakeSettingsRead(d, meta)
+}
+
+func resourceAwsLakeFormationDataLakeSettingsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lakeformationconn
+
+	output, err := conn.GetDataLakeSettings(&lakeformation.GetDataLakeSettingsInput{
+		CatalogId: aws.String(d.Id()),
+	})
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeEntityNotFoundException) {
+		log.Printf("[WARN] Lake Formation Data Lake Settings (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lake Formation Data Lake Settings (%s): %w", d.Id(), err)
+	}
+
+	if output == nil || output.DataLakeSettings == nil {
+		return fmt.Errorf("error reading Lake Formation Data Lake Settings (%s): empty response", d.Id())
+	}
+
+	d.Set("catalog_id", d.Id())
+
+	return setLakeFormationDataLakeSettings(d, output.DataLakeSettings)
+}
+
+func resourceAwsLakeFormationDataLakeSettingsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lakeformationconn
+
+	// Data Lake Settings cannot be deleted, so reset them to an empty configuration.
+	input := &lakeformation.PutDataLakeSettingsInput{
+		CatalogId: aws.String(d.Id()),
+		DataLakeSettings: &lakeformation.DataLakeSettings{
+			CreateDatabaseDefaultPermissions: []*lakeformation.PrincipalPermissions{},
+			CreateTableDefaultPermissions:    []*lakeformation.PrincipalPermissions{},
+			DataLakeAdmins:                   []*lakeformation.DataLakePrincipal{},
+			TrustedResourceOwners:            []*string{},
+		},
+	}
+
+	log.Printf("[DEBUG] Resetting Lake Formation Data Lake Settings: %s", input)
+	_, err := conn.PutDataLakeSettings(input)
+
+	if tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeEntityNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error resetting Lake Formation Data Lake Settings (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// setLakeFormationDataLakeSettings sets the data lake settings attributes
+// shared by the aws_lakeformation_data_lake_settings resource and data source.
+func setLakeFormationDataLakeSettings(d *schema.ResourceData, settings *lakeformation.DataLakeSettings) error {
+	if err := d.Set("admins", flattenLakeFormationDataLakePrincipals(settings.DataLakeAdmins)); err != nil {
+		return fmt.Errorf("error setting admins: %w", err)
+	}
+
+	if err := d.Set("create_database_default_permissions", flattenLakeFormationPrincipalPermissions(settings.CreateDatabaseDefaultPermissions)); err != nil {
+		return fmt.Errorf("error setting create_database_default_permissions: %w", err)
+	}
+
+	if err := d.Set("create_table_default_permissions", flattenLakeFormationPrincipalPermissions(settings.CreateTableDefaultPermissions)); err != nil {
+		return fmt.Errorf("error setting create_table_default_permissions: %w", err)
+	}
+
+	if err := d.Set("trusted_resource_owners", flattenStringList(settings.TrustedResourceOwners)); err != nil {
+		return fmt.Errorf("error setting trusted_resource_owners: %w", err)
+	}
+
+	return nil
+}
+
+func expandLakeFormationPrincipalPermissions(tfList []interface{}) []*lakeformation.PrincipalPermissions {
+	apiObjects := make([]*lakeformation.PrincipalPermissions, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &lakeformation.PrincipalPermissions{
+			Permissions: expandStringSet(tfMap["permissions"].(*schema.Set)),
+		}
+
+		if v, ok := tfMap["principal"].(string); ok && v != "" {
+			apiObject.Principal = &lakeformation.DataLakePrincipal{
+				DataLakePrincipalIdentifier: aws.String(v),
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenLakeFormationPrincipalPermissions(apiObjects []*lakeformation.PrincipalPermissions) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"permissions": flattenStringSet(apiObject.Permissions),
+		}
+
+		if apiObject.Principal != nil {
+			tfMap["principal"] = aws.StringValue(apiObject.Principal.DataLakePrincipalIdentifier)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func expandLakeFormationDataLakePrincipals(s *schema.Set) []*lakeformation.DataLakePrincipal {
+	apiObjects := make([]*lakeformation.DataLakePrincipal, 0, s.Len())
+
+	for _, v := range s.List() {
+		apiObjects = append(apiObjects, &lakeformation.DataLakePrincipal{
+			DataLakePrincipalIdentifier: aws.String(v.(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenLakeFormationDataLakePrincipals(apiObjects []*lakeformation.DataLakePrincipal) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, aws.StringValue(apiObject.DataLakePrincipalIdentifier))
+	}
+
+	return tfList
+}
@@ -0,0 +1,157 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfawsresource"
+)
+
+func testAccAWSLakeFormationDataLakeSettings_basic(t *testing.T) {
+	callerIdentityName := "data.aws_caller_identity.current"
+	resourceName := "aws_lakeformation_data_lake_settings.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(lakeformation.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLakeFormationDataLakeSettingsEmpty,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLakeFormationDataLakeSettingsConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLakeFormationDataLakeSettingsExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "catalog_id", callerIdentityName, "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "admins.#", "1"),
+					tfawsresource.TestCheckTypeSetElemAttrPair(resourceName, "admins.*", callerIdentityName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "trusted_resource_owners.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "trusted_resource_owners.0", callerIdentityName, "account_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAWSLakeFormationDataLakeSettings_disappears(t *testing.T) {
+	resourceName := "aws_lakeformation_data_lake_settings.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(lakeformation.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLakeFormationDataLakeSettingsEmpty,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLakeFormationDataLakeSettingsConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLakeFormationDataLakeSettingsExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsLakeFormationDataLakeSettings(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccAWSLakeFormationDataLakeSettings_withoutCatalogId(t *testing.T) {
+	callerIdentityName := "data.aws_caller_identity.current"
+	resourceName := "aws_lakeformation_data_lake_settings.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(lakeformation.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLakeFormationDataLakeSettingsEmpty,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLakeFormationDataLakeSettingsConfig_withoutCatalogId,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLakeFormationDataLakeSettingsExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "catalog_id", callerIdentityName, "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "admins.#", "1"),
+					tfawsresource.TestCheckTypeSetElemAttrPair(resourceName, "admins.*", callerIdentityName, "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSLakeFormationDataLakeSettingsEmpty(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).lakeformationconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lakeformation_data_lake_settings" {
+			continue
+		}
+
+		output, err := conn.GetDataLakeSettings(&lakeformation.GetDataLakeSettingsInput{
+			CatalogId: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error reading Lake Formation Data Lake Settings (%s): %w", rs.Primary.ID, err)
+		}
+
+		if output != nil && output.DataLakeSettings != nil && len(output.DataLakeSettings.DataLakeAdmins) > 0 {
+			return fmt.Errorf("Lake Formation Data Lake Settings (%s) admins not reset", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSLakeFormationDataLakeSettingsExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lake Formation Data Lake Settings catalog ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).lakeformationconn
+
+		_, err := conn.GetDataLakeSettings(&lakeformation.GetDataLakeSettingsInput{
+			CatalogId: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+const testAccAWSLakeFormationDataLakeSettingsConfig_basic = `
+data "aws_caller_identity" "current" {}
+
+resource "aws_lakeformation_data_lake_settings" "test" {
+  catalog_id = data.aws_caller_identity.current.account_id
+
+  create_database_default_permissions {
+    permissions = ["SELECT", "ALTER", "DROP"]
+    principal   = "IAM_ALLOWED_PRINCIPALS"
+  }
+
+  create_table_default_permissions {
+    permissions = ["ALL"]
+    principal   = "IAM_ALLOWED_PRINCIPALS"
+  }
+
+  admins                  = [data.aws_caller_identity.current.arn]
+  trusted_resource_owners = [data.aws_caller_identity.current.account_id]
+}
+`
+
+const testAccAWSLakeFormationDataLakeSettingsConfig_withoutCatalogId = `
+data "aws_caller_identity" "current" {}
+
+resource "aws_lakeformation_data_lake_settings" "test" {
+  admins = [data.aws_caller_identity.current.arn]
+}
+`
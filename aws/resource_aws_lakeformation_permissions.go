@@ -0,0 +1,476 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/hashcode"
+	iamwaiter "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iam/waiter"
+)
+
+func resourceAwsLakeFormationPermissions() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLakeFormationPermissionsCreate,
+		Read:   resourceAwsLakeFormationPermissionsRead,
+		Delete: resourceAwsLakeFormationPermissionsDelete,
+
+		Schema: map[string]*schema.Schema{
+			"catalog_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"catalog_resource": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      false,
+				ExactlyOneOf: lakeFormationPermissionsResourceTypes,
+			},
+			"data_location": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: lakeFormationPermissionsResourceTypes,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validateArn,
+						},
+						"catalog_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validateAwsAccountId,
+						},
+					},
+				},
+			},
+			"database": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: lakeFormationPermissionsResourceTypes,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"catalog_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validateAwsAccountId,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"permissions": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(lakeformation.Permission_Values(), false),
+				},
+			},
+			"permissions_with_grant_option": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(lakeformation.Permission_Values(), false),
+				},
+			},
+			"principal": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateLakeFormationPrincipal,
+			},
+			"table": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: lakeFormationPermissionsResourceTypes,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"catalog_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validateAwsAccountId,
+						},
+						"database_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							AtLeastOneOf: []string{"table.0.name", "table.0.wildcard"},
+						},
+						"wildcard": {
+							Type:         schema.TypeBool,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      false,
+							AtLeastOneOf: []string{"table.0.name", "table.0.wildcard"},
+						},
+					},
+				},
+			},
+			"table_with_columns": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: lakeFormationPermissionsResourceTypes,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"catalog_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validateAwsAccountId,
+						},
+						"column_names": {
+							Type:         schema.TypeSet,
+							Optional:     true,
+							ForceNew:     true,
+							Elem:         &schema.Schema{Type: schema.TypeString},
+							AtLeastOneOf: []string{"table_with_columns.0.column_names", "table_with_columns.0.wildcard"},
+						},
+						"database_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"excluded_column_names": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"wildcard": {
+							Type:         schema.TypeBool,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      false,
+							AtLeastOneOf: []string{"table_with_columns.0.column_names", "table_with_columns.0.wildcard"},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+var lakeFormationPermissionsResourceTypes = []string{
+	"catalog_resource",
+	"data_location",
+	"database",
+	"table",
+	"table_with_columns",
+}
+
+func resourceAwsLakeFormationPermissionsCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lakeformationconn
+
+	input := &lakeformation.GrantPermissionsInput{
+		Permissions: expandStringSet(d.Get("permissions").(*schema.Set)),
+		Principal: &lakeformation.DataLakePrincipal{
+			DataLakePrincipalIdentifier: aws.String(d.Get("principal").(string)),
+		},
+		Resource: expandLakeFormationPermissionsResource(d),
+	}
+
+	if v, ok := d.GetOk("catalog_id"); ok {
+		input.CatalogId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("permissions_with_grant_option"); ok && v.(*schema.Set).Len() > 0 {
+		input.PermissionsWithGrantOption = expandStringSet(v.(*schema.Set))
+	}
+
+	log.Printf("[DEBUG] Granting Lake Formation Permissions: %s", input)
+	err := resource.Retry(iamwaiter.PropagationTimeout, func() *resource.RetryError {
+		_, err := conn.GrantPermissions(input)
+
+		// Newly created IAM principals may not yet be visible to Lake Formation.
+		if tfawserr.ErrMessageContains(err, lakeformation.ErrCodeInvalidInputException, "Invalid principal") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if isResourceTimeoutError(err) {
+		_, err = conn.GrantPermissions(input)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error granting Lake Formation Permissions: %w", err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(input.String())))
+
+	return resourceAwsLakeFormationPermissionsRead(d, meta)
+}
+
+func resourceAwsLakeFormationPermissionsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lakeformationconn
+
+	principalResourcePermissions, err := listLakeFormationPermissions(conn, d)
+
+	if err != nil {
+		return fmt.Errorf("error reading Lake Formation Permissions (%s): %w", d.Id(), err)
+	}
+
+	if len(principalResourcePermissions) == 0 {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Lake Formation Permissions (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] Lake Formation Permissions (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	return setLakeFormationPermissions(d, principalResourcePermissions)
+}
+
+func resourceAwsLakeFormationPermissionsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lakeformationconn
+
+	input := &lakeformation.RevokePermissionsInput{
+		Permissions: expandStringSet(d.Get("permissions").(*schema.Set)),
+		Principal: &lakeformation.DataLakePrincipal{
+			DataLakePrincipalIdentifier: aws.String(d.Get("principal").(string)),
+		},
+		Resource: expandLakeFormationPermissionsResource(d),
+	}
+
+	if v, ok := d.GetOk("catalog_id"); ok {
+		input.CatalogId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("permissions_with_grant_option"); ok && v.(*schema.Set).Len() > 0 {
+		input.PermissionsWithGrantOption = expandStringSet(v.(*schema.Set))
+	}
+
+	log.Printf("[DEBUG] Revoking Lake Formation Permissions: %s", input)
+	_, err := conn.RevokePermissions(input)
+
+	if tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeEntityNotFoundException) {
+		return nil
+	}
+
+	if tfawserr.ErrMessageContains(err, lakeformation.ErrCodeInvalidInputException, "No permissions revoked") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error revoking Lake Formation Permissions (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// listLakeFormationPermissions returns the permissions granted to the configured principal
+// on the configured resource. It is shared by the aws_lakeformation_permissions resource and data source.
+func listLakeFormationPermissions(conn *lakeformation.LakeFormation, d *schema.ResourceData) ([]*lakeformation.PrincipalResourcePermissions, error) {
+	input := &lakeformation.ListPermissionsInput{
+		Principal: &lakeformation.DataLakePrincipal{
+			DataLakePrincipalIdentifier: aws.String(d.Get("principal").(string)),
+		},
+		Resource: expandLakeFormationPermissionsResource(d),
+	}
+
+	if v, ok := d.GetOk("catalog_id"); ok {
+		input.CatalogId = aws.String(v.(string))
+	}
+
+	// Permissions on columns are listed by filtering on the table.
+	_, tableWithColumns := d.GetOk("table_with_columns")
+	columnWildcard := false
+
+	if tableWithColumns {
+		v := input.Resource.TableWithColumns
+		input.Resource = &lakeformation.Resource{
+			Table: &lakeformation.TableResource{
+				CatalogId:    v.CatalogId,
+				DatabaseName: v.DatabaseName,
+				Name:         v.Name,
+			},
+		}
+		columnWildcard = v.ColumnWildcard != nil && len(v.ColumnWildcard.ExcludedColumnNames) == 0
+	}
+
+	_, table := d.GetOk("table")
+
+	var principalResourcePermissions []*lakeformation.PrincipalResourcePermissions
+
+	err := conn.ListPermissionsPages(input, func(page *lakeformation.ListPermissionsOutput, lastPage bool) bool {
+		for _, permission := range page.PrincipalResourcePermissions {
+			if permission == nil || permission.Resource == nil {
+				continue
+			}
+
+			switch {
+			case tableWithColumns:
+				// A column wildcard without exclusions is equivalent to, and reported as, a table grant.
+				if permission.Resource.TableWithColumns == nil && !(columnWildcard && permission.Resource.Table != nil) {
+					continue
+				}
+			case table:
+				if permission.Resource.Table == nil {
+					continue
+				}
+			}
+
+			principalResourcePermissions = append(principalResourcePermissions, permission)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return principalResourcePermissions, nil
+}
+
+// setLakeFormationPermissions sets the permissions attributes from the union of all matching grants.
+func setLakeFormationPermissions(d *schema.ResourceData, principalResourcePermissions []*lakeformation.PrincipalResourcePermissions) error {
+	var permissions, permissionsWithGrantOption []*string
+
+	for _, v := range principalResourcePermissions {
+		permissions = append(permissions, v.Permissions...)
+		permissionsWithGrantOption = append(permissionsWithGrantOption, v.PermissionsWithGrantOption...)
+	}
+
+	d.Set("principal", principalResourcePermissions[0].Principal.DataLakePrincipalIdentifier)
+
+	if err := d.Set("permissions", flattenStringSet(permissions)); err != nil {
+		return fmt.Errorf("error setting permissions: %w", err)
+	}
+
+	if err := d.Set("permissions_with_grant_option", flattenStringSet(permissionsWithGrantOption)); err != nil {
+		return fmt.Errorf("error setting permissions_with_grant_option: %w", err)
+	}
+
+	return nil
+}
+
+func expandLakeFormationPermissionsResource(d *schema.ResourceData) *lakeformation.Resource {
+	apiObject := &lakeformation.Resource{}
+
+	if d.Get("catalog_resource").(bool) {
+		apiObject.Catalog = &lakeformation.CatalogResource{}
+	}
+
+	if v, ok := d.GetOk("data_location"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		apiObject.DataLocation = &lakeformation.DataLocationResource{
+			ResourceArn: aws.String(tfMap["arn"].(string)),
+		}
+
+		if v, ok := tfMap["catalog_id"].(string); ok && v != "" {
+			apiObject.DataLocation.CatalogId = aws.String(v)
+		}
+	}
+
+	if v, ok := d.GetOk("database"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		apiObject.Database = &lakeformation.DatabaseResource{
+			Name: aws.String(tfMap["name"].(string)),
+		}
+
+		if v, ok := tfMap["catalog_id"].(string); ok && v != "" {
+			apiObject.Database.CatalogId = aws.String(v)
+		}
+	}
+
+	if v, ok := d.GetOk("table"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		apiObject.Table = &lakeformation.TableResource{
+			DatabaseName: aws.String(tfMap["database_name"].(string)),
+		}
+
+		if v, ok := tfMap["catalog_id"].(string); ok && v != "" {
+			apiObject.Table.CatalogId = aws.String(v)
+		}
+
+		if v, ok := tfMap["name"].(string); ok && v != "" {
+			apiObject.Table.Name = aws.String(v)
+		}
+
+		if v, ok := tfMap["wildcard"].(bool); ok && v {
+			apiObject.Table.TableWildcard = &lakeformation.TableWildcard{}
+		}
+	}
+
+	if v, ok := d.GetOk("table_with_columns"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		apiObject.TableWithColumns = &lakeformation.TableWithColumnsResource{
+			DatabaseName: aws.String(tfMap["database_name"].(string)),
+			Name:         aws.String(tfMap["name"].(string)),
+		}
+
+		if v, ok := tfMap["catalog_id"].(string); ok && v != "" {
+			apiObject.TableWithColumns.CatalogId = aws.String(v)
+		}
+
+		if v, ok := tfMap["column_names"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.TableWithColumns.ColumnNames = expandStringSet(v)
+		}
+
+		if v, ok := tfMap["wildcard"].(bool); ok && v {
+			apiObject.TableWithColumns.ColumnWildcard = &lakeformation.ColumnWildcard{}
+
+			if v, ok := tfMap["excluded_column_names"].(*schema.Set); ok && v.Len() > 0 {
+				apiObject.TableWithColumns.ColumnWildcard.ExcludedColumnNames = expandStringSet(v)
+			}
+		}
+	}
+
+	return apiObject
+}
@@ -0,0 +1,462 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfawsresource"
+)
+
+func testAccAWSLakeFormationPermissions_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lakeformation_permissions.test"
+	roleName := "aws_iam_role.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(lakeformation.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLakeFormationPermissionsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLakeFormationPermissionsConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLakeFormationPermissionsExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "principal", roleName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "catalog_resource", "true"),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", "1"),
+					tfawsresource.TestCheckTypeSetElemAttr(resourceName, "permissions.*", lakeformation.PermissionCreateDatabase),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSLakeFormationPermissions_dataLocation(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lakeformation_permissions.test"
+	roleName := "aws_iam_role.test"
+	bucketName := "aws_s3_bucket.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(lakeformation.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLakeFormationPermissionsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLakeFormationPermissionsConfig_dataLocation(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLakeFormationPermissionsExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "principal", roleName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", "1"),
+					tfawsresource.TestCheckTypeSetElemAttr(resourceName, "permissions.*", lakeformation.PermissionDataLocationAccess),
+					resource.TestCheckResourceAttr(resourceName, "data_location.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "data_location.0.arn", bucketName, "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSLakeFormationPermissions_database(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lakeformation_permissions.test"
+	roleName := "aws_iam_role.test"
+	dbName := "aws_glue_catalog_database.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(lakeformation.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLakeFormationPermissionsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLakeFormationPermissionsConfig_database(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLakeFormationPermissionsExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "principal", roleName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "database.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "database.0.name", dbName, "name"),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", "3"),
+					tfawsresource.TestCheckTypeSetElemAttr(resourceName, "permissions.*", lakeformation.PermissionAlter),
+					tfawsresource.TestCheckTypeSetElemAttr(resourceName, "permissions.*", lakeformation.PermissionCreateTable),
+					tfawsresource.TestCheckTypeSetElemAttr(resourceName, "permissions.*", lakeformation.PermissionDrop),
+					resource.TestCheckResourceAttr(resourceName, "permissions_with_grant_option.#", "1"),
+					tfawsresource.TestCheckTypeSetElemAttr(resourceName, "permissions_with_grant_option.*", lakeformation.PermissionCreateTable),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSLakeFormationPermissions_table(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lakeformation_permissions.test"
+	roleName := "aws_iam_role.test"
+	tableName := "aws_glue_catalog_table.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(lakeformation.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLakeFormationPermissionsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLakeFormationPermissionsConfig_table(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLakeFormationPermissionsExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "principal", roleName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "table.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "table.0.database_name", tableName, "database_name"),
+					resource.TestCheckResourceAttrPair(resourceName, "table.0.name", tableName, "name"),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", "3"),
+					tfawsresource.TestCheckTypeSetElemAttr(resourceName, "permissions.*", lakeformation.PermissionAlter),
+					tfawsresource.TestCheckTypeSetElemAttr(resourceName, "permissions.*", lakeformation.PermissionDelete),
+					tfawsresource.TestCheckTypeSetElemAttr(resourceName, "permissions.*", lakeformation.PermissionDescribe),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSLakeFormationPermissions_tableWildcard(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lakeformation_permissions.test"
+	databaseResourceName := "aws_glue_catalog_database.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(lakeformation.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLakeFormationPermissionsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLakeFormationPermissionsConfig_tableWildcard(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLakeFormationPermissionsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "table.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "table.0.database_name", databaseResourceName, "name"),
+					resource.TestCheckResourceAttr(resourceName, "table.0.wildcard", "true"),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", "1"),
+					tfawsresource.TestCheckTypeSetElemAttr(resourceName, "permissions.*", lakeformation.PermissionSelect),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSLakeFormationPermissions_tableWithColumns(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lakeformation_permissions.test"
+	roleName := "aws_iam_role.test"
+	tableName := "aws_glue_catalog_table.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(lakeformation.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLakeFormationPermissionsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLakeFormationPermissionsConfig_tableWithColumns(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLakeFormationPermissionsExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "principal", roleName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "table_with_columns.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "table_with_columns.0.database_name", tableName, "database_name"),
+					resource.TestCheckResourceAttrPair(resourceName, "table_with_columns.0.name", tableName, "name"),
+					resource.TestCheckResourceAttr(resourceName, "table_with_columns.0.column_names.#", "2"),
+					tfawsresource.TestCheckTypeSetElemAttr(resourceName, "table_with_columns.0.column_names.*", "event"),
+					tfawsresource.TestCheckTypeSetElemAttr(resourceName, "table_with_columns.0.column_names.*", "timestamp"),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", "1"),
+					tfawsresource.TestCheckTypeSetElemAttr(resourceName, "permissions.*", lakeformation.PermissionSelect),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSLakeFormationPermissionsDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).lakeformationconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lakeformation_permissions" {
+			continue
+		}
+
+		count, err := testAccAWSLakeFormationPermissionsCount(conn, rs)
+
+		if err != nil {
+			return err
+		}
+
+		if count > 0 {
+			return fmt.Errorf("Lake Formation Permissions (%s) still exist: %d", rs.Primary.ID, count)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSLakeFormationPermissionsExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).lakeformationconn
+
+		count, err := testAccAWSLakeFormationPermissionsCount(conn, rs)
+
+		if err != nil {
+			return err
+		}
+
+		if count == 0 {
+			return fmt.Errorf("Lake Formation Permissions (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+// testAccAWSLakeFormationPermissionsCount returns the number of permissions granted
+// to the principal of the resource on any of the database or table it references.
+func testAccAWSLakeFormationPermissionsCount(conn *lakeformation.LakeFormation, rs *terraform.ResourceState) (int, error) {
+	input := &lakeformation.ListPermissionsInput{
+		Principal: &lakeformation.DataLakePrincipal{
+			DataLakePrincipalIdentifier: aws.String(rs.Primary.Attributes["principal"]),
+		},
+		Resource: &lakeformation.Resource{},
+	}
+
+	switch {
+	case rs.Primary.Attributes["catalog_resource"] == "true":
+		input.Resource.Catalog = &lakeformation.CatalogResource{}
+	case rs.Primary.Attributes["data_location.#"] == "1":
+		input.Resource.DataLocation = &lakeformation.DataLocationResource{
+			ResourceArn: aws.String(rs.Primary.Attributes["data_location.0.arn"]),
+		}
+	case rs.Primary.Attributes["database.#"] == "1":
+		input.Resource.Database = &lakeformation.DatabaseResource{
+			Name: aws.String(rs.Primary.Attributes["database.0.name"]),
+		}
+	case rs.Primary.Attributes["table.#"] == "1":
+		input.Resource.Table = &lakeformation.TableResource{
+			DatabaseName: aws.String(rs.Primary.Attributes["table.0.database_name"]),
+		}
+		if v := rs.Primary.Attributes["table.0.name"]; v != "" {
+			input.Resource.Table.Name = aws.String(v)
+		}
+		if rs.Primary.Attributes["table.0.wildcard"] == "true" {
+			input.Resource.Table.TableWildcard = &lakeformation.TableWildcard{}
+		}
+	case rs.Primary.Attributes["table_with_columns.#"] == "1":
+		input.Resource.Table = &lakeformation.TableResource{
+			DatabaseName: aws.String(rs.Primary.Attributes["table_with_columns.0.database_name"]),
+			Name:         aws.String(rs.Primary.Attributes["table_with_columns.0.name"]),
+		}
+	}
+
+	count := 0
+
+	err := conn.ListPermissionsPages(input, func(page *lakeformation.ListPermissionsOutput, lastPage bool) bool {
+		count += len(page.PrincipalResourcePermissions)
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeEntityNotFoundException) {
+		return 0, nil
+	}
+
+	return count, err
+}
+
+func testAccAWSLakeFormationPermissionsConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+  path = "/"
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Action": "sts:AssumeRole",
+    "Effect": "Allow",
+    "Principal": {
+      "Service": "glue.${data.aws_partition.current.dns_suffix}"
+    }
+  }]
+}
+EOF
+}
+
+resource "aws_lakeformation_data_lake_settings" "test" {
+  admins = [data.aws_caller_identity.current.arn]
+}
+`, rName)
+}
+
+func testAccAWSLakeFormationPermissionsConfigGlueTable(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+}
+
+resource "aws_glue_catalog_table" "test" {
+  name          = %[1]q
+  database_name = aws_glue_catalog_database.test.name
+
+  storage_descriptor {
+    columns {
+      name = "event"
+      type = "string"
+    }
+
+    columns {
+      name = "timestamp"
+      type = "date"
+    }
+
+    columns {
+      name = "value"
+      type = "double"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAWSLakeFormationPermissionsConfig_basic(rName string) string {
+	return composeConfig(
+		testAccAWSLakeFormationPermissionsConfigBase(rName),
+		`
+resource "aws_lakeformation_permissions" "test" {
+  principal        = aws_iam_role.test.arn
+  permissions      = ["CREATE_DATABASE"]
+  catalog_resource = true
+
+  # for consistency, ensure that admins are setup before testing
+  depends_on = [aws_lakeformation_data_lake_settings.test]
+}
+`)
+}
+
+func testAccAWSLakeFormationPermissionsConfig_dataLocation(rName string) string {
+	return composeConfig(
+		testAccAWSLakeFormationPermissionsConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_lakeformation_resource" "test" {
+  arn = aws_s3_bucket.test.arn
+}
+
+resource "aws_lakeformation_permissions" "test" {
+  principal   = aws_iam_role.test.arn
+  permissions = ["DATA_LOCATION_ACCESS"]
+
+  data_location {
+    arn = aws_lakeformation_resource.test.arn
+  }
+
+  # for consistency, ensure that admins are setup before testing
+  depends_on = [aws_lakeformation_data_lake_settings.test]
+}
+`, rName))
+}
+
+func testAccAWSLakeFormationPermissionsConfig_database(rName string) string {
+	return composeConfig(
+		testAccAWSLakeFormationPermissionsConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+}
+
+resource "aws_lakeformation_permissions" "test" {
+  principal                     = aws_iam_role.test.arn
+  permissions                   = ["ALTER", "CREATE_TABLE", "DROP"]
+  permissions_with_grant_option = ["CREATE_TABLE"]
+
+  database {
+    name = aws_glue_catalog_database.test.name
+  }
+
+  # for consistency, ensure that admins are setup before testing
+  depends_on = [aws_lakeformation_data_lake_settings.test]
+}
+`, rName))
+}
+
+func testAccAWSLakeFormationPermissionsConfig_table(rName string) string {
+	return composeConfig(
+		testAccAWSLakeFormationPermissionsConfigBase(rName),
+		testAccAWSLakeFormationPermissionsConfigGlueTable(rName),
+		`
+resource "aws_lakeformation_permissions" "test" {
+  principal   = aws_iam_role.test.arn
+  permissions = ["ALTER", "DELETE", "DESCRIBE"]
+
+  table {
+    database_name = aws_glue_catalog_table.test.database_name
+    name          = aws_glue_catalog_table.test.name
+  }
+
+  # for consistency, ensure that admins are setup before testing
+  depends_on = [aws_lakeformation_data_lake_settings.test]
+}
+`)
+}
+
+func testAccAWSLakeFormationPermissionsConfig_tableWildcard(rName string) string {
+	return composeConfig(
+		testAccAWSLakeFormationPermissionsConfigBase(rName),
+		testAccAWSLakeFormationPermissionsConfigGlueTable(rName),
+		`
+resource "aws_lakeformation_permissions" "test" {
+  principal   = aws_iam_role.test.arn
+  permissions = ["SELECT"]
+
+  table {
+    database_name = aws_glue_catalog_database.test.name
+    wildcard      = true
+  }
+
+  # for consistency, ensure that admins are setup before testing
+  depends_on = [aws_lakeformation_data_lake_settings.test, aws_glue_catalog_table.test]
+}
+`)
+}
+
+func testAccAWSLakeFormationPermissionsConfig_tableWithColumns(rName string) string {
+	return composeConfig(
+		testAccAWSLakeFormationPermissionsConfigBase(rName),
+		testAccAWSLakeFormationPermissionsConfigGlueTable(rName),
+		`
+resource "aws_lakeformation_permissions" "test" {
+  principal   = aws_iam_role.test.arn
+  permissions = ["SELECT"]
+
+  table_with_columns {
+    database_name = aws_glue_catalog_table.test.database_name
+    name          = aws_glue_catalog_table.test.name
+    column_names  = ["event", "timestamp"]
+  }
+
+  # for consistency, ensure that admins are setup before testing
+  depends_on = [aws_lakeformation_data_lake_settings.test]
+}
+`)
+}
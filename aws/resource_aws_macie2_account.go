@@ -0,0 +1,151 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfmacie2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/macie2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/macie2/finder"
+)
+
+func resourceAwsMacie2Account() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMacie2AccountCreate,
+		Read:   resourceAwsMacie2AccountRead,
+		Update: resourceAwsMacie2AccountUpdate,
+		Delete: resourceAwsMacie2AccountDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"finding_publishing_frequency": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(macie2.FindingPublishingFrequency_Values(), false),
+			},
+
+			"service_role": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(macie2.MacieStatus_Values(), false),
+			},
+
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsMacie2AccountCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+
+	input := &macie2.EnableMacieInput{
+		ClientToken: aws.String(resource.UniqueId()),
+	}
+
+	if v, ok := d.GetOk("finding_publishing_frequency"); ok {
+		input.FindingPublishingFrequency = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("status"); ok {
+		input.Status = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Enabling Macie Account: %s", input)
+	_, err := conn.EnableMacie(input)
+
+	if err != nil {
+		return fmt.Errorf("error enabling Macie Account: %w", err)
+	}
+
+	d.SetId(meta.(*AWSClient).accountid)
+
+	return resourceAwsMacie2AccountRead(d, meta)
+}
+
+func resourceAwsMacie2AccountRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+
+	output, err := finder.MacieSession(conn)
+
+	if !d.IsNewResource() && tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, tfmacie2.ErrMessageMacieNotEnabled) {
+		log.Printf("[WARN] Macie Account (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Macie Account (%s): %w", d.Id(), err)
+	}
+
+	d.Set("created_at", aws.TimeValue(output.CreatedAt).Format(time.RFC3339))
+	d.Set("finding_publishing_frequency", output.FindingPublishingFrequency)
+	d.Set("service_role", output.ServiceRole)
+	d.Set("status", output.Status)
+	d.Set("updated_at", aws.TimeValue(output.UpdatedAt).Format(time.RFC3339))
+
+	return nil
+}
+
+func resourceAwsMacie2AccountUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+
+	input := &macie2.UpdateMacieSessionInput{}
+
+	if d.HasChange("finding_publishing_frequency") {
+		input.FindingPublishingFrequency = aws.String(d.Get("finding_publishing_frequency").(string))
+	}
+
+	if d.HasChange("status") {
+		input.Status = aws.String(d.Get("status").(string))
+	}
+
+	log.Printf("[DEBUG] Updating Macie Account: %s", input)
+	_, err := conn.UpdateMacieSession(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Macie Account (%s): %w", d.Id(), err)
+	}
+
+	return resourceAwsMacie2AccountRead(d, meta)
+}
+
+func resourceAwsMacie2AccountDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+
+	log.Printf("[DEBUG] Disabling Macie Account: %s", d.Id())
+	_, err := conn.DisableMacie(&macie2.DisableMacieInput{})
+
+	if tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, tfmacie2.ErrMessageMacieNotEnabled) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error disabling Macie Account (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
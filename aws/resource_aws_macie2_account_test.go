@@ -0,0 +1,243 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfmacie2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/macie2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/macie2/finder"
+)
+
+func testAccAwsMacie2Account_basic(t *testing.T) {
+	var macie2Output macie2.GetMacieSessionOutput
+	resourceName := "aws_macie2_account.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(macie2.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2AccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2AccountConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2AccountExists(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "finding_publishing_frequency", macie2.FindingPublishingFrequencyFifteenMinutes),
+					resource.TestCheckResourceAttr(resourceName, "status", macie2.MacieStatusEnabled),
+					testAccCheckResourceAttrGlobalARN(resourceName, "service_role", "iam", "role/aws-service-role/macie.amazonaws.com/AWSServiceRoleForAmazonMacie"),
+					testAccCheckResourceAttrRfc3339(resourceName, "created_at"),
+					testAccCheckResourceAttrRfc3339(resourceName, "updated_at"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2Account_FindingPublishingFrequency(t *testing.T) {
+	var macie2Output macie2.GetMacieSessionOutput
+	resourceName := "aws_macie2_account.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(macie2.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2AccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2AccountConfigWithFinding(macie2.FindingPublishingFrequencyFifteenMinutes),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2AccountExists(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "finding_publishing_frequency", macie2.FindingPublishingFrequencyFifteenMinutes),
+					resource.TestCheckResourceAttr(resourceName, "status", macie2.MacieStatusEnabled),
+				),
+			},
+			{
+				Config: testAccAwsMacie2AccountConfigWithFinding(macie2.FindingPublishingFrequencyOneHour),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2AccountExists(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "finding_publishing_frequency", macie2.FindingPublishingFrequencyOneHour),
+					resource.TestCheckResourceAttr(resourceName, "status", macie2.MacieStatusEnabled),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2Account_WithStatus(t *testing.T) {
+	var macie2Output macie2.GetMacieSessionOutput
+	resourceName := "aws_macie2_account.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(macie2.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2AccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2AccountConfigWithStatus(macie2.MacieStatusEnabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2AccountExists(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "status", macie2.MacieStatusEnabled),
+				),
+			},
+			{
+				Config: testAccAwsMacie2AccountConfigWithStatus(macie2.MacieStatusPaused),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2AccountExists(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "status", macie2.MacieStatusPaused),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2Account_WithFindingAndStatus(t *testing.T) {
+	var macie2Output macie2.GetMacieSessionOutput
+	resourceName := "aws_macie2_account.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(macie2.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2AccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2AccountConfigWithFindingAndStatus(macie2.FindingPublishingFrequencyFifteenMinutes, macie2.MacieStatusEnabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2AccountExists(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "finding_publishing_frequency", macie2.FindingPublishingFrequencyFifteenMinutes),
+					resource.TestCheckResourceAttr(resourceName, "status", macie2.MacieStatusEnabled),
+				),
+			},
+			{
+				Config: testAccAwsMacie2AccountConfigWithFindingAndStatus(macie2.FindingPublishingFrequencySixHours, macie2.MacieStatusPaused),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2AccountExists(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "finding_publishing_frequency", macie2.FindingPublishingFrequencySixHours),
+					resource.TestCheckResourceAttr(resourceName, "status", macie2.MacieStatusPaused),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2Account_disappears(t *testing.T) {
+	var macie2Output macie2.GetMacieSessionOutput
+	resourceName := "aws_macie2_account.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(macie2.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2AccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2AccountConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2AccountExists(resourceName, &macie2Output),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsMacie2Account(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsMacie2AccountDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).macie2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_macie2_account" {
+			continue
+		}
+
+		_, err := finder.MacieSession(conn)
+
+		if tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, tfmacie2.ErrMessageMacieNotEnabled) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Macie Account (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAwsMacie2AccountExists(resourceName string, v *macie2.GetMacieSessionOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Macie Account ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).macie2conn
+
+		output, err := finder.MacieSession(conn)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAwsMacie2AccountConfigBasic() string {
+	return `
+resource "aws_macie2_account" "test" {}
+`
+}
+
+func testAccAwsMacie2AccountConfigWithFinding(finding string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {
+  finding_publishing_frequency = %[1]q
+}
+`, finding)
+}
+
+func testAccAwsMacie2AccountConfigWithStatus(status string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {
+  status = %[1]q
+}
+`, status)
+}
+
+func testAccAwsMacie2AccountConfigWithFindingAndStatus(finding, status string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {
+  finding_publishing_frequency = %[1]q
+  status                       = %[2]q
+}
+`, finding, status)
+}
@@ -0,0 +1,476 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/naming"
+	tfmacie2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/macie2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/macie2/finder"
+)
+
+func testAccAwsMacie2ClassificationJob_basic(t *testing.T) {
+	var macie2Output macie2.DescribeClassificationJobOutput
+	resourceName := "aws_macie2_classification_job.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(macie2.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2ClassificationJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2ClassificationJobConfigBasic(rName, macie2.JobTypeOneTime),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2ClassificationJobExists(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "job_type", macie2.JobTypeOneTime),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "name_prefix", ""),
+					resource.TestCheckResourceAttr(resourceName, "s3_job_definition.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "s3_job_definition.0.bucket_definitions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "s3_job_definition.0.bucket_definitions.0.buckets.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "s3_job_definition.0.bucket_definitions.0.buckets.0", "aws_s3_bucket.test", "bucket"),
+					testAccMatchResourceAttrRegionalARN(resourceName, "job_arn", "macie2", regexp.MustCompile(`classification-job/.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "job_id", resourceName, "id"),
+					testAccCheckResourceAttrRfc3339(resourceName, "created_at"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2ClassificationJob_Name_Generated(t *testing.T) {
+	var macie2Output macie2.DescribeClassificationJobOutput
+	resourceName := "aws_macie2_classification_job.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(macie2.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2ClassificationJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2ClassificationJobConfigNameGenerated(rName, macie2.JobTypeOneTime),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2ClassificationJobExists(resourceName, &macie2Output),
+					naming.TestCheckResourceAttrNameGenerated(resourceName, "name"),
+					resource.TestCheckResourceAttr(resourceName, "name_prefix", "terraform-"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2ClassificationJob_NamePrefix(t *testing.T) {
+	var macie2Output macie2.DescribeClassificationJobOutput
+	resourceName := "aws_macie2_classification_job.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(macie2.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2ClassificationJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2ClassificationJobConfigNamePrefix(rName, "tf-acc-test-prefix-", macie2.JobTypeOneTime),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2ClassificationJobExists(resourceName, &macie2Output),
+					naming.TestCheckResourceAttrNameFromPrefix(resourceName, "name", "tf-acc-test-prefix-"),
+					resource.TestCheckResourceAttr(resourceName, "name_prefix", "tf-acc-test-prefix-"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2ClassificationJob_disappears(t *testing.T) {
+	var macie2Output macie2.DescribeClassificationJobOutput
+	resourceName := "aws_macie2_classification_job.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(macie2.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2ClassificationJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2ClassificationJobConfigBasic(rName, macie2.JobTypeOneTime),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2ClassificationJobExists(resourceName, &macie2Output),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsMacie2ClassificationJob(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2ClassificationJob_Status(t *testing.T) {
+	var macie2Output, macie2Output2 macie2.DescribeClassificationJobOutput
+	resourceName := "aws_macie2_classification_job.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(macie2.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2ClassificationJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2ClassificationJobConfigStatus(rName, macie2.JobStatusRunning),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2ClassificationJobExists(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "job_status", macie2.JobStatusRunning),
+					resource.TestCheckResourceAttr(resourceName, "job_type", macie2.JobTypeScheduled),
+				),
+			},
+			{
+				Config: testAccAwsMacie2ClassificationJobConfigStatus(rName, macie2.JobStatusPaused),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2ClassificationJobExists(resourceName, &macie2Output2),
+					testAccCheckAwsMacie2ClassificationJobNotRecreated(&macie2Output, &macie2Output2),
+					resource.TestCheckResourceAttr(resourceName, "job_status", macie2.JobStatusPaused),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2ClassificationJob_complete(t *testing.T) {
+	var macie2Output macie2.DescribeClassificationJobOutput
+	resourceName := "aws_macie2_classification_job.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	description := "this is a description"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(macie2.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2ClassificationJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2ClassificationJobConfigComplete(rName, description),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2ClassificationJobExists(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "description", description),
+					resource.TestCheckResourceAttr(resourceName, "initial_run", "true"),
+					resource.TestCheckResourceAttr(resourceName, "job_type", macie2.JobTypeScheduled),
+					resource.TestCheckResourceAttr(resourceName, "sampling_percentage", "100"),
+					resource.TestCheckResourceAttr(resourceName, "schedule_frequency.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "schedule_frequency.0.weekly_schedule", macie2.DayOfWeekMonday),
+					resource.TestCheckResourceAttr(resourceName, "s3_job_definition.0.scoping.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "s3_job_definition.0.scoping.0.excludes.0.and.0.simple_scope_term.0.comparator", macie2.JobComparatorEq),
+					resource.TestCheckResourceAttr(resourceName, "s3_job_definition.0.scoping.0.excludes.0.and.0.simple_scope_term.0.key", macie2.ScopeFilterKeyObjectExtension),
+					resource.TestCheckResourceAttr(resourceName, "s3_job_definition.0.scoping.0.excludes.0.and.0.simple_scope_term.0.values.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "s3_job_definition.0.scoping.0.excludes.0.and.0.simple_scope_term.0.values.0", "test"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2ClassificationJob_WithTags(t *testing.T) {
+	var macie2Output macie2.DescribeClassificationJobOutput
+	resourceName := "aws_macie2_classification_job.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(macie2.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2ClassificationJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2ClassificationJobConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2ClassificationJobExists(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsMacie2ClassificationJobConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2ClassificationJobExists(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsMacie2ClassificationJobExists(resourceName string, v *macie2.DescribeClassificationJobOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Macie Classification Job ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).macie2conn
+
+		output, err := finder.ClassificationJobByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAwsMacie2ClassificationJobDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).macie2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_macie2_classification_job" {
+			continue
+		}
+
+		output, err := finder.ClassificationJobByID(conn, rs.Primary.ID)
+
+		if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
+			tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, tfmacie2.ErrMessageMacieNotEnabled) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if status := aws.StringValue(output.JobStatus); status != macie2.JobStatusCancelled && status != macie2.JobStatusComplete {
+			return fmt.Errorf("Macie Classification Job (%s) still exists with status %s", rs.Primary.ID, status)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsMacie2ClassificationJobNotRecreated(i, j *macie2.DescribeClassificationJobOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(i.JobId) != aws.StringValue(j.JobId) {
+			return fmt.Errorf("Macie Classification Job recreated")
+		}
+
+		return nil
+	}
+}
+
+func testAccAwsMacie2ClassificationJobConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_macie2_account" "test" {}
+`, rName)
+}
+
+func testAccAwsMacie2ClassificationJobConfigBasic(rName, jobType string) string {
+	return composeConfig(testAccAwsMacie2ClassificationJobConfigBase(rName), fmt.Sprintf(`
+resource "aws_macie2_classification_job" "test" {
+  name     = %[1]q
+  job_type = %[2]q
+
+  s3_job_definition {
+    bucket_definitions {
+      account_id = data.aws_caller_identity.current.account_id
+      buckets    = [aws_s3_bucket.test.bucket]
+    }
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`, rName, jobType))
+}
+
+func testAccAwsMacie2ClassificationJobConfigNameGenerated(rName, jobType string) string {
+	return composeConfig(testAccAwsMacie2ClassificationJobConfigBase(rName), fmt.Sprintf(`
+resource "aws_macie2_classification_job" "test" {
+  job_type = %[1]q
+
+  s3_job_definition {
+    bucket_definitions {
+      account_id = data.aws_caller_identity.current.account_id
+      buckets    = [aws_s3_bucket.test.bucket]
+    }
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`, jobType))
+}
+
+func testAccAwsMacie2ClassificationJobConfigNamePrefix(rName, namePrefix, jobType string) string {
+	return composeConfig(testAccAwsMacie2ClassificationJobConfigBase(rName), fmt.Sprintf(`
+resource "aws_macie2_classification_job" "test" {
+  name_prefix = %[1]q
+  job_type    = %[2]q
+
+  s3_job_definition {
+    bucket_definitions {
+      account_id = data.aws_caller_identity.current.account_id
+      buckets    = [aws_s3_bucket.test.bucket]
+    }
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`, namePrefix, jobType))
+}
+
+func testAccAwsMacie2ClassificationJobConfigStatus(rName, jobStatus string) string {
+	return composeConfig(testAccAwsMacie2ClassificationJobConfigBase(rName), fmt.Sprintf(`
+resource "aws_macie2_classification_job" "test" {
+  name       = %[1]q
+  job_type   = "SCHEDULED"
+  job_status = %[2]q
+
+  schedule_frequency {
+    daily_schedule = true
+  }
+
+  s3_job_definition {
+    bucket_definitions {
+      account_id = data.aws_caller_identity.current.account_id
+      buckets    = [aws_s3_bucket.test.bucket]
+    }
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`, rName, jobStatus))
+}
+
+func testAccAwsMacie2ClassificationJobConfigComplete(rName, description string) string {
+	return composeConfig(testAccAwsMacie2ClassificationJobConfigBase(rName), fmt.Sprintf(`
+resource "aws_macie2_classification_job" "test" {
+  name                = %[1]q
+  description         = %[2]q
+  job_type            = "SCHEDULED"
+  initial_run         = true
+  sampling_percentage = 100
+
+  schedule_frequency {
+    weekly_schedule = "MONDAY"
+  }
+
+  s3_job_definition {
+    bucket_definitions {
+      account_id = data.aws_caller_identity.current.account_id
+      buckets    = [aws_s3_bucket.test.bucket]
+    }
+
+    scoping {
+      excludes {
+        and {
+          simple_scope_term {
+            comparator = "EQ"
+            key        = "OBJECT_EXTENSION"
+            values     = ["test"]
+          }
+        }
+      }
+    }
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`, rName, description))
+}
+
+func testAccAwsMacie2ClassificationJobConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return composeConfig(testAccAwsMacie2ClassificationJobConfigBase(rName), fmt.Sprintf(`
+resource "aws_macie2_classification_job" "test" {
+  name     = %[1]q
+  job_type = "SCHEDULED"
+
+  schedule_frequency {
+    daily_schedule = true
+  }
+
+  s3_job_definition {
+    bucket_definitions {
+      account_id = data.aws_caller_identity.current.account_id
+      buckets    = [aws_s3_bucket.test.bucket]
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccAwsMacie2ClassificationJobConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(testAccAwsMacie2ClassificationJobConfigBase(rName), fmt.Sprintf(`
+resource "aws_macie2_classification_job" "test" {
+  name     = %[1]q
+  job_type = "SCHEDULED"
+
+  schedule_frequency {
+    daily_schedule = true
+  }
+
+  s3_job_definition {
+    bucket_definitions {
+      account_id = data.aws_caller_identity.current.account_id
+      buckets    = [aws_s3_bucket.test.bucket]
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
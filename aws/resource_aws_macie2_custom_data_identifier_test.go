@@ -0,0 +1,361 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/naming"
+	tfmacie2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/macie2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/macie2/finder"
+)
+
+func testAccAwsMacie2CustomDataIdentifier_basic(t *testing.T) {
+	var macie2Output macie2.GetCustomDataIdentifierOutput
+	resourceName := "aws_macie2_custom_data_identifier.test"
+	regex := "[0-9]{3}-[0-9]{2}-[0-9]{4}"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(macie2.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2CustomDataIdentifierDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2CustomDataIdentifierConfigNameGenerated(regex),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2CustomDataIdentifierExists(resourceName, &macie2Output),
+					naming.TestCheckResourceAttrNameGenerated(resourceName, "name"),
+					resource.TestCheckResourceAttr(resourceName, "name_prefix", "terraform-"),
+					resource.TestCheckResourceAttr(resourceName, "regex", regex),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "macie2", regexp.MustCompile(`custom-data-identifier/.+`)),
+					testAccCheckResourceAttrRfc3339(resourceName, "created_at"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2CustomDataIdentifier_Name_Generated(t *testing.T) {
+	var macie2Output macie2.GetCustomDataIdentifierOutput
+	resourceName := "aws_macie2_custom_data_identifier.test"
+	regex := "[0-9]{3}-[0-9]{2}-[0-9]{4}"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(macie2.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2CustomDataIdentifierDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2CustomDataIdentifierConfigNameGenerated(regex),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2CustomDataIdentifierExists(resourceName, &macie2Output),
+					naming.TestCheckResourceAttrNameGenerated(resourceName, "name"),
+					resource.TestCheckResourceAttr(resourceName, "name_prefix", "terraform-"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2CustomDataIdentifier_NamePrefix(t *testing.T) {
+	var macie2Output macie2.GetCustomDataIdentifierOutput
+	resourceName := "aws_macie2_custom_data_identifier.test"
+	regex := "[0-9]{3}-[0-9]{2}-[0-9]{4}"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(macie2.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2CustomDataIdentifierDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2CustomDataIdentifierConfigNamePrefix("tf-acc-test-prefix-", regex),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2CustomDataIdentifierExists(resourceName, &macie2Output),
+					naming.TestCheckResourceAttrNameFromPrefix(resourceName, "name", "tf-acc-test-prefix-"),
+					resource.TestCheckResourceAttr(resourceName, "name_prefix", "tf-acc-test-prefix-"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2CustomDataIdentifier_disappears(t *testing.T) {
+	var macie2Output macie2.GetCustomDataIdentifierOutput
+	resourceName := "aws_macie2_custom_data_identifier.test"
+	regex := "[0-9]{3}-[0-9]{2}-[0-9]{4}"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(macie2.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2CustomDataIdentifierDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2CustomDataIdentifierConfigNameGenerated(regex),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2CustomDataIdentifierExists(resourceName, &macie2Output),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsMacie2CustomDataIdentifier(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2CustomDataIdentifier_WithClassificationJob(t *testing.T) {
+	var macie2Output macie2.GetCustomDataIdentifierOutput
+	resourceName := "aws_macie2_custom_data_identifier.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	regex := "[0-9]{3}-[0-9]{2}-[0-9]{4}"
+	description := "this is a description"
+	descriptionUpdated := "this is a updated description"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(macie2.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2CustomDataIdentifierDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2CustomDataIdentifierConfigComplete(rName, regex, description),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2CustomDataIdentifierExists(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "regex", regex),
+					resource.TestCheckResourceAttr(resourceName, "description", description),
+					resource.TestCheckResourceAttr(resourceName, "maximum_match_distance", "10"),
+					resource.TestCheckResourceAttr(resourceName, "keywords.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ignore_words.#", "1"),
+				),
+			},
+			{
+				Config: testAccAwsMacie2CustomDataIdentifierConfigComplete(rName, regex, descriptionUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2CustomDataIdentifierExists(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "description", descriptionUpdated),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2CustomDataIdentifier_WithTags(t *testing.T) {
+	var macie2Output macie2.GetCustomDataIdentifierOutput
+	resourceName := "aws_macie2_custom_data_identifier.test"
+	regex := "[0-9]{3}-[0-9]{2}-[0-9]{4}"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(macie2.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2CustomDataIdentifierDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2CustomDataIdentifierConfigTags1(regex, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2CustomDataIdentifierExists(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsMacie2CustomDataIdentifierConfigTags2(regex, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2CustomDataIdentifierExists(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAwsMacie2CustomDataIdentifierConfigTags1(regex, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2CustomDataIdentifierExists(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsMacie2CustomDataIdentifierExists(resourceName string, v *macie2.GetCustomDataIdentifierOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Macie Custom Data Identifier ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).macie2conn
+
+		output, err := finder.CustomDataIdentifierByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("Macie Custom Data Identifier (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAwsMacie2CustomDataIdentifierDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).macie2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_macie2_custom_data_identifier" {
+			continue
+		}
+
+		output, err := finder.CustomDataIdentifierByID(conn, rs.Primary.ID)
+
+		if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
+			tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, tfmacie2.ErrMessageMacieNotEnabled) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil {
+			return fmt.Errorf("Macie Custom Data Identifier (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAwsMacie2CustomDataIdentifierConfigNameGenerated(regex string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_custom_data_identifier" "test" {
+  regex = %[1]q
+
+  depends_on = [aws_macie2_account.test]
+}
+`, regex)
+}
+
+func testAccAwsMacie2CustomDataIdentifierConfigNamePrefix(namePrefix, regex string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_custom_data_identifier" "test" {
+  name_prefix = %[1]q
+  regex       = %[2]q
+
+  depends_on = [aws_macie2_account.test]
+}
+`, namePrefix, regex)
+}
+
+func testAccAwsMacie2CustomDataIdentifierConfigComplete(rName, regex, description string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_custom_data_identifier" "test" {
+  name                   = %[1]q
+  regex                  = %[2]q
+  description            = %[3]q
+  maximum_match_distance = 10
+  keywords               = ["test"]
+  ignore_words           = ["ignore"]
+
+  depends_on = [aws_macie2_account.test]
+}
+
+resource "aws_macie2_classification_job" "test" {
+  job_type = "ONE_TIME"
+  name     = %[1]q
+
+  custom_data_identifier_ids = [aws_macie2_custom_data_identifier.test.id]
+
+  s3_job_definition {
+    bucket_definitions {
+      account_id = data.aws_caller_identity.current.account_id
+      buckets    = [aws_s3_bucket.test.bucket]
+    }
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`, rName, regex, description)
+}
+
+func testAccAwsMacie2CustomDataIdentifierConfigTags1(regex, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_custom_data_identifier" "test" {
+  regex = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`, regex, tagKey1, tagValue1)
+}
+
+func testAccAwsMacie2CustomDataIdentifierConfigTags2(regex, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_custom_data_identifier" "test" {
+  regex = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`, regex, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,146 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tfmacie2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/macie2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/macie2/finder"
+)
+
+func resourceAwsMacie2InvitationAccepter() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMacie2InvitationAccepterCreate,
+		Read:   resourceAwsMacie2InvitationAccepterRead,
+		Delete: resourceAwsMacie2InvitationAccepterDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"administrator_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+
+			"invitation_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsMacie2InvitationAccepterCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+
+	administratorAccountID := d.Get("administrator_account_id").(string)
+	var invitation *macie2.Invitation
+
+	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		var err error
+
+		invitation, err = finder.InvitationByAdministratorAccountID(conn, administratorAccountID)
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		if invitation == nil {
+			return resource.RetryableError(fmt.Errorf("unable to find pending Macie Invitation from administrator account (%s)", administratorAccountID))
+		}
+
+		return nil
+	})
+
+	if isResourceTimeoutError(err) {
+		invitation, err = finder.InvitationByAdministratorAccountID(conn, administratorAccountID)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Macie Invitations: %w", err)
+	}
+
+	if invitation == nil {
+		return fmt.Errorf("error accepting Macie Invitation: no pending invitation from administrator account (%s)", administratorAccountID)
+	}
+
+	input := &macie2.AcceptInvitationInput{
+		InvitationId:  invitation.InvitationId,
+		MasterAccount: aws.String(administratorAccountID),
+	}
+
+	log.Printf("[DEBUG] Accepting Macie Invitation: %s", input)
+	_, err = conn.AcceptInvitation(input)
+
+	if err != nil {
+		return fmt.Errorf("error accepting Macie Invitation (%s): %w", aws.StringValue(invitation.InvitationId), err)
+	}
+
+	d.SetId(administratorAccountID)
+
+	return resourceAwsMacie2InvitationAccepterRead(d, meta)
+}
+
+func resourceAwsMacie2InvitationAccepterRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+
+	master, err := finder.MasterAccount(conn)
+
+	if !d.IsNewResource() && (tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
+		tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, tfmacie2.ErrMessageMacieNotEnabled)) {
+		log.Printf("[WARN] Macie Invitation Accepter (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Macie Invitation Accepter (%s): %w", d.Id(), err)
+	}
+
+	if master == nil || aws.StringValue(master.AccountId) != d.Id() {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Macie Invitation Accepter (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] Macie Invitation Accepter (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("administrator_account_id", master.AccountId)
+	d.Set("invitation_id", master.InvitationId)
+
+	return nil
+}
+
+func resourceAwsMacie2InvitationAccepterDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+
+	log.Printf("[DEBUG] Disassociating Macie Account from administrator account: %s", d.Id())
+	_, err := conn.DisassociateFromMasterAccount(&macie2.DisassociateFromMasterAccountInput{})
+
+	if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
+		tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, tfmacie2.ErrMessageMacieNotEnabled) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error disassociating Macie Account from administrator account (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
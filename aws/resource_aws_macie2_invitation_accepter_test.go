@@ -0,0 +1,132 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfmacie2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/macie2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/macie2/finder"
+)
+
+func testAccAwsMacie2InvitationAccepter_basic(t *testing.T) {
+	var providers []*schema.Provider
+	resourceName := "aws_macie2_invitation_accepter.member"
+	email := "required@example.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccAlternateAccountPreCheck(t)
+			testAccPartitionHasServicePreCheck(macie2.EndpointsID, t)
+		},
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckAwsMacie2InvitationAccepterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2InvitationAccepterConfigBasic(email),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2InvitationAccepterExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "administrator_account_id", "data.aws_caller_identity.administrator", "account_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "invitation_id"),
+				),
+			},
+			{
+				Config:            testAccAwsMacie2InvitationAccepterConfigBasic(email),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsMacie2InvitationAccepterExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Macie Invitation Accepter ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).macie2conn
+
+		master, err := finder.MasterAccount(conn)
+
+		if err != nil {
+			return err
+		}
+
+		if master == nil {
+			return fmt.Errorf("Macie Invitation Accepter (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAwsMacie2InvitationAccepterDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).macie2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_macie2_invitation_accepter" {
+			continue
+		}
+
+		master, err := finder.MasterAccount(conn)
+
+		if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
+			tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, tfmacie2.ErrMessageMacieNotEnabled) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if master != nil {
+			return fmt.Errorf("Macie Invitation Accepter (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAwsMacie2InvitationAccepterConfigBasic(email string) string {
+	return composeConfig(testAccAlternateAccountProviderConfig(), fmt.Sprintf(`
+data "aws_caller_identity" "administrator" {
+  provider = "awsalternate"
+}
+
+data "aws_caller_identity" "member" {}
+
+resource "aws_macie2_account" "administrator" {
+  provider = "awsalternate"
+}
+
+resource "aws_macie2_account" "member" {}
+
+resource "aws_macie2_member" "administrator" {
+  provider           = "awsalternate"
+  account_id         = data.aws_caller_identity.member.account_id
+  email              = %[1]q
+  invite             = true
+  invitation_message = "This is a message of the invite"
+
+  depends_on = [aws_macie2_account.administrator]
+}
+
+resource "aws_macie2_invitation_accepter" "member" {
+  administrator_account_id = data.aws_caller_identity.administrator.account_id
+
+  depends_on = [aws_macie2_member.administrator, aws_macie2_account.member]
+}
+`, email))
+}
@@ -0,0 +1,324 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tfmacie2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/macie2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/macie2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/macie2/waiter"
+)
+
+func resourceAwsMacie2Member() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMacie2MemberCreate,
+		Read:   resourceAwsMacie2MemberRead,
+		Update: resourceAwsMacie2MemberUpdate,
+		Delete: resourceAwsMacie2MemberDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+
+			"administrator_account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"disable_email_notification": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"email": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"invitation_message": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"invite": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
+			"invited_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"relationship_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(macie2.MacieStatus_Values(), false),
+			},
+
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsMacie2MemberCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	accountID := d.Get("account_id").(string)
+	input := &macie2.CreateMemberInput{
+		Account: &macie2.AccountDetail{
+			AccountId: aws.String(accountID),
+			Email:     aws.String(d.Get("email").(string)),
+		},
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().Macie2Tags()
+	}
+
+	log.Printf("[DEBUG] Creating Macie Member: %s", input)
+	_, err := conn.CreateMember(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Macie Member (%s): %w", accountID, err)
+	}
+
+	d.SetId(accountID)
+
+	if d.Get("invite").(bool) {
+		if err := resourceAwsMacie2MemberInvite(d, conn); err != nil {
+			return err
+		}
+	}
+
+	if v, ok := d.GetOk("status"); ok && v.(string) != macie2.MacieStatusEnabled {
+		if err := resourceAwsMacie2MemberUpdateSession(d, conn); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsMacie2MemberRead(d, meta)
+}
+
+func resourceAwsMacie2MemberRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	output, err := finder.MemberByAccountID(conn, d.Id())
+
+	if !d.IsNewResource() && (tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
+		tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, tfmacie2.ErrMessageMacieNotEnabled)) {
+		log.Printf("[WARN] Macie Member (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Macie Member (%s): %w", d.Id(), err)
+	}
+
+	d.Set("account_id", output.AccountId)
+	d.Set("administrator_account_id", output.MasterAccountId)
+	d.Set("arn", output.Arn)
+	d.Set("email", output.Email)
+
+	if output.InvitedAt != nil {
+		d.Set("invited_at", aws.TimeValue(output.InvitedAt).Format(time.RFC3339))
+	} else {
+		d.Set("invited_at", nil)
+	}
+
+	status := aws.StringValue(output.RelationshipStatus)
+	d.Set("relationship_status", status)
+
+	switch status {
+	case macie2.RelationshipStatusEnabled, macie2.RelationshipStatusInvited, macie2.RelationshipStatusEmailVerificationInProgress:
+		d.Set("invite", true)
+		d.Set("status", macie2.MacieStatusEnabled)
+	case macie2.RelationshipStatusPaused:
+		d.Set("invite", true)
+		d.Set("status", macie2.MacieStatusPaused)
+	default:
+		d.Set("invite", false)
+	}
+
+	if output.UpdatedAt != nil {
+		d.Set("updated_at", aws.TimeValue(output.UpdatedAt).Format(time.RFC3339))
+	} else {
+		d.Set("updated_at", nil)
+	}
+
+	tags := keyvaluetags.Macie2KeyValueTags(output.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsMacie2MemberUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+
+	if d.HasChange("invite") {
+		if d.Get("invite").(bool) {
+			if err := resourceAwsMacie2MemberInvite(d, conn); err != nil {
+				return err
+			}
+		} else {
+			log.Printf("[DEBUG] Disassociating Macie Member: %s", d.Id())
+			_, err := conn.DisassociateMember(&macie2.DisassociateMemberInput{
+				Id: aws.String(d.Id()),
+			})
+
+			if err != nil {
+				return fmt.Errorf("error disassociating Macie Member (%s): %w", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("status") {
+		if err := resourceAwsMacie2MemberUpdateSession(d, conn); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.Macie2UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Macie Member (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsMacie2MemberRead(d, meta)
+}
+
+func resourceAwsMacie2MemberDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+
+	switch d.Get("relationship_status").(string) {
+	case macie2.RelationshipStatusEnabled, macie2.RelationshipStatusPaused:
+		log.Printf("[DEBUG] Disassociating Macie Member: %s", d.Id())
+		_, err := conn.DisassociateMember(&macie2.DisassociateMemberInput{
+			Id: aws.String(d.Id()),
+		})
+
+		if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
+			tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, tfmacie2.ErrMessageMacieNotEnabled) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error disassociating Macie Member (%s): %w", d.Id(), err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting Macie Member: %s", d.Id())
+	_, err := conn.DeleteMember(&macie2.DeleteMemberInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
+		tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, tfmacie2.ErrMessageMacieNotEnabled) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Macie Member (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsMacie2MemberInvite(d *schema.ResourceData, conn *macie2.Macie2) error {
+	input := &macie2.CreateInvitationsInput{
+		AccountIds:               aws.StringSlice([]string{d.Id()}),
+		DisableEmailNotification: aws.Bool(d.Get("disable_email_notification").(bool)),
+	}
+
+	if v, ok := d.GetOk("invitation_message"); ok {
+		input.Message = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Inviting Macie Member: %s", input)
+	output, err := conn.CreateInvitations(input)
+
+	if err != nil {
+		return fmt.Errorf("error inviting Macie Member (%s): %w", d.Id(), err)
+	}
+
+	for _, unprocessedAccount := range output.UnprocessedAccounts {
+		if unprocessedAccount == nil {
+			continue
+		}
+
+		return fmt.Errorf("error inviting Macie Member (%s): %s: %s", d.Id(), aws.StringValue(unprocessedAccount.ErrorCode), aws.StringValue(unprocessedAccount.ErrorMessage))
+	}
+
+	if _, err := waiter.MemberInvited(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for Macie Member (%s) invitation: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsMacie2MemberUpdateSession(d *schema.ResourceData, conn *macie2.Macie2) error {
+	input := &macie2.UpdateMemberSessionInput{
+		Id:     aws.String(d.Id()),
+		Status: aws.String(d.Get("status").(string)),
+	}
+
+	log.Printf("[DEBUG] Updating Macie Member session: %s", input)
+	_, err := conn.UpdateMemberSession(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Macie Member (%s) status: %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,309 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfmacie2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/macie2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/macie2/finder"
+)
+
+const macie2MemberEmail = "required@example.com"
+
+func testAccAwsMacie2Member_basic(t *testing.T) {
+	var providers []*schema.Provider
+	var macie2Output macie2.GetMemberOutput
+	resourceName := "aws_macie2_member.test"
+	dataSourceAlternate := "data.aws_caller_identity.member"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccAlternateAccountPreCheck(t)
+			testAccPartitionHasServicePreCheck(macie2.EndpointsID, t)
+		},
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckAwsMacie2MemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2MemberConfigBasic(macie2MemberEmail),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2MemberExists(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "relationship_status", macie2.RelationshipStatusCreated),
+					resource.TestCheckResourceAttrPair(resourceName, "account_id", dataSourceAlternate, "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "email", macie2MemberEmail),
+					resource.TestCheckResourceAttr(resourceName, "invite", "false"),
+					testAccCheckResourceAttrAccountID(resourceName, "administrator_account_id"),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "macie2", regexp.MustCompile(`member/.+`)),
+					testAccCheckResourceAttrRfc3339(resourceName, "updated_at"),
+				),
+			},
+			{
+				Config:            testAccAwsMacie2MemberConfigBasic(macie2MemberEmail),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"disable_email_notification",
+					"invitation_message",
+				},
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2Member_disappears(t *testing.T) {
+	var providers []*schema.Provider
+	var macie2Output macie2.GetMemberOutput
+	resourceName := "aws_macie2_member.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccAlternateAccountPreCheck(t)
+			testAccPartitionHasServicePreCheck(macie2.EndpointsID, t)
+		},
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckAwsMacie2MemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2MemberConfigBasic(macie2MemberEmail),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2MemberExists(resourceName, &macie2Output),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsMacie2Member(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2Member_invite(t *testing.T) {
+	var providers []*schema.Provider
+	var macie2Output macie2.GetMemberOutput
+	resourceName := "aws_macie2_member.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccAlternateAccountPreCheck(t)
+			testAccPartitionHasServicePreCheck(macie2.EndpointsID, t)
+		},
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckAwsMacie2MemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2MemberConfigInvite(macie2MemberEmail, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2MemberExists(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "relationship_status", macie2.RelationshipStatusCreated),
+					resource.TestCheckResourceAttr(resourceName, "invite", "false"),
+				),
+			},
+			{
+				Config: testAccAwsMacie2MemberConfigInvite(macie2MemberEmail, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2MemberExists(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "relationship_status", macie2.RelationshipStatusInvited),
+					resource.TestCheckResourceAttr(resourceName, "invite", "true"),
+					testAccCheckResourceAttrRfc3339(resourceName, "invited_at"),
+				),
+			},
+			{
+				Config:            testAccAwsMacie2MemberConfigInvite(macie2MemberEmail, true),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"disable_email_notification",
+					"invitation_message",
+				},
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2Member_withTags(t *testing.T) {
+	var providers []*schema.Provider
+	var macie2Output macie2.GetMemberOutput
+	resourceName := "aws_macie2_member.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccAlternateAccountPreCheck(t)
+			testAccPartitionHasServicePreCheck(macie2.EndpointsID, t)
+		},
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckAwsMacie2MemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2MemberConfigTags1(macie2MemberEmail, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2MemberExists(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config:            testAccAwsMacie2MemberConfigTags1(macie2MemberEmail, "key1", "value1"),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"disable_email_notification",
+					"invitation_message",
+				},
+			},
+			{
+				Config: testAccAwsMacie2MemberConfigTags2(macie2MemberEmail, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2MemberExists(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsMacie2MemberExists(resourceName string, v *macie2.GetMemberOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Macie Member ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).macie2conn
+
+		output, err := finder.MemberByAccountID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAwsMacie2MemberDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).macie2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_macie2_member" {
+			continue
+		}
+
+		_, err := finder.MemberByAccountID(conn, rs.Primary.ID)
+
+		if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
+			tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, tfmacie2.ErrMessageMacieNotEnabled) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Macie Member (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAwsMacie2MemberConfigBasic(email string) string {
+	return composeConfig(testAccAlternateAccountProviderConfig(), fmt.Sprintf(`
+data "aws_caller_identity" "member" {
+  provider = "awsalternate"
+}
+
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_member" "test" {
+  account_id = data.aws_caller_identity.member.account_id
+  email      = %[1]q
+
+  depends_on = [aws_macie2_account.test]
+}
+`, email))
+}
+
+func testAccAwsMacie2MemberConfigInvite(email string, invite bool) string {
+	return composeConfig(testAccAlternateAccountProviderConfig(), fmt.Sprintf(`
+data "aws_caller_identity" "member" {
+  provider = "awsalternate"
+}
+
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_account" "member" {
+  provider = "awsalternate"
+}
+
+resource "aws_macie2_member" "test" {
+  account_id                 = data.aws_caller_identity.member.account_id
+  email                      = %[1]q
+  invite                     = %[2]t
+  invitation_message         = "This is a message of the invite"
+  disable_email_notification = true
+
+  depends_on = [aws_macie2_account.test, aws_macie2_account.member]
+}
+`, email, invite))
+}
+
+func testAccAwsMacie2MemberConfigTags1(email, tagKey1, tagValue1 string) string {
+	return composeConfig(testAccAlternateAccountProviderConfig(), fmt.Sprintf(`
+data "aws_caller_identity" "member" {
+  provider = "awsalternate"
+}
+
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_member" "test" {
+  account_id = data.aws_caller_identity.member.account_id
+  email      = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`, email, tagKey1, tagValue1))
+}
+
+func testAccAwsMacie2MemberConfigTags2(email, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(testAccAlternateAccountProviderConfig(), fmt.Sprintf(`
+data "aws_caller_identity" "member" {
+  provider = "awsalternate"
+}
+
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_member" "test" {
+  account_id = data.aws_caller_identity.member.account_id
+  email      = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`, email, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,275 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkmanager/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkmanager/waiter"
+)
+
+func resourceAwsNetworkManagerDevice() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsNetworkManagerDeviceCreate,
+		Read:   resourceAwsNetworkManagerDeviceRead,
+		Update: resourceAwsNetworkManagerDeviceUpdate,
+		Delete: resourceAwsNetworkManagerDeviceDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsNetworkManagerDeviceImport,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"global_network_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"location": networkManagerLocationSchema(),
+
+			"model": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"serial_number": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"site_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"vendor": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceAwsNetworkManagerDeviceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).networkmanagerconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	globalNetworkID := d.Get("global_network_id").(string)
+
+	input := &networkmanager.CreateDeviceInput{
+		GlobalNetworkId: aws.String(globalNetworkID),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("location"); ok {
+		input.Location = expandNetworkManagerLocation(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("model"); ok {
+		input.Model = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("serial_number"); ok {
+		input.SerialNumber = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("site_id"); ok {
+		input.SiteId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("type"); ok {
+		input.Type = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("vendor"); ok {
+		input.Vendor = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().NetworkmanagerTags()
+	}
+
+	log.Printf("[DEBUG] Creating Network Manager Device: %s", input)
+	output, err := conn.CreateDevice(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Network Manager Device in Global Network (%s): %w", globalNetworkID, err)
+	}
+
+	d.SetId(aws.StringValue(output.Device.DeviceId))
+
+	if _, err := waiter.DeviceCreated(conn, globalNetworkID, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Network Manager Device (%s) creation: %w", d.Id(), err)
+	}
+
+	return resourceAwsNetworkManagerDeviceRead(d, meta)
+}
+
+func resourceAwsNetworkManagerDeviceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).networkmanagerconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	device, err := finder.DeviceByID(conn, d.Get("global_network_id").(string), d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Network Manager Device (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Network Manager Device (%s): %w", d.Id(), err)
+	}
+
+	if device == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Network Manager Device (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] Network Manager Device (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", device.DeviceArn)
+	d.Set("description", device.Description)
+	d.Set("global_network_id", device.GlobalNetworkId)
+
+	if err := d.Set("location", flattenNetworkManagerLocation(device.Location)); err != nil {
+		return fmt.Errorf("error setting location: %w", err)
+	}
+
+	d.Set("model", device.Model)
+	d.Set("serial_number", device.SerialNumber)
+	d.Set("site_id", device.SiteId)
+	d.Set("type", device.Type)
+	d.Set("vendor", device.Vendor)
+
+	tags := keyvaluetags.NetworkmanagerKeyValueTags(device.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsNetworkManagerDeviceUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).networkmanagerconn
+	globalNetworkID := d.Get("global_network_id").(string)
+
+	if d.HasChanges("description", "location", "model", "serial_number", "site_id", "type", "vendor") {
+		input := &networkmanager.UpdateDeviceInput{
+			Description:     aws.String(d.Get("description").(string)),
+			DeviceId:        aws.String(d.Id()),
+			GlobalNetworkId: aws.String(globalNetworkID),
+			Location:        expandNetworkManagerLocation(d.Get("location").([]interface{})),
+			Model:           aws.String(d.Get("model").(string)),
+			SerialNumber:    aws.String(d.Get("serial_number").(string)),
+			SiteId:          aws.String(d.Get("site_id").(string)),
+			Type:            aws.String(d.Get("type").(string)),
+			Vendor:          aws.String(d.Get("vendor").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating Network Manager Device: %s", input)
+		_, err := conn.UpdateDevice(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Network Manager Device (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waiter.DeviceUpdated(conn, globalNetworkID, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for Network Manager Device (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.NetworkmanagerUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Network Manager Device (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsNetworkManagerDeviceRead(d, meta)
+}
+
+func resourceAwsNetworkManagerDeviceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).networkmanagerconn
+	globalNetworkID := d.Get("global_network_id").(string)
+
+	log.Printf("[DEBUG] Deleting Network Manager Device (%s)", d.Id())
+	_, err := conn.DeleteDevice(&networkmanager.DeleteDeviceInput{
+		DeviceId:        aws.String(d.Id()),
+		GlobalNetworkId: aws.String(globalNetworkID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Network Manager Device (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.DeviceDeleted(conn, globalNetworkID, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Network Manager Device (%s) deletion: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsNetworkManagerDeviceImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	globalNetworkID, deviceID, err := networkManagerParseGlobalNetworkResourceARN(d.Id(), "device")
+
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(deviceID)
+	d.Set("global_network_id", globalNetworkID)
+
+	return []*schema.ResourceData{d}, nil
+}
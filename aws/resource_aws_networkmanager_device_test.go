@@ -0,0 +1,276 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkmanager/finder"
+)
+
+func TestAccAWSNetworkManagerDevice_basic(t *testing.T) {
+	resourceName := "aws_networkmanager_device.test"
+	globalNetworkResourceName := "aws_networkmanager_global_network.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(networkmanager.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsNetworkManagerDeviceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsNetworkManagerDeviceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsNetworkManagerDeviceExists(resourceName),
+					testAccMatchResourceAttrGlobalARN(resourceName, "arn", "networkmanager", regexp.MustCompile(`device/global-network-.+/device-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "global_network_id", globalNetworkResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "location.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "model", ""),
+					resource.TestCheckResourceAttr(resourceName, "serial_number", ""),
+					resource.TestCheckResourceAttr(resourceName, "site_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "type", ""),
+					resource.TestCheckResourceAttr(resourceName, "vendor", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAwsNetworkManagerImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSNetworkManagerDevice_disappears(t *testing.T) {
+	resourceName := "aws_networkmanager_device.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(networkmanager.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsNetworkManagerDeviceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsNetworkManagerDeviceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsNetworkManagerDeviceExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsNetworkManagerDevice(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSNetworkManagerDevice_allAttributes(t *testing.T) {
+	resourceName := "aws_networkmanager_device.test"
+	site1ResourceName := "aws_networkmanager_site.test1"
+	site2ResourceName := "aws_networkmanager_site.test2"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(networkmanager.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsNetworkManagerDeviceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsNetworkManagerDeviceConfigAllAttributes("test1", "description1", "model1", "serial1", "type1", "vendor1", "Stuart, FL"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsNetworkManagerDeviceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttr(resourceName, "location.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "location.0.address", "Stuart, FL"),
+					resource.TestCheckResourceAttr(resourceName, "model", "model1"),
+					resource.TestCheckResourceAttr(resourceName, "serial_number", "serial1"),
+					resource.TestCheckResourceAttrPair(resourceName, "site_id", site1ResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "type", "type1"),
+					resource.TestCheckResourceAttr(resourceName, "vendor", "vendor1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAwsNetworkManagerImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsNetworkManagerDeviceConfigAllAttributes("test2", "description2", "model2", "serial2", "type2", "vendor2", "Brisbane, QLD"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsNetworkManagerDeviceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+					resource.TestCheckResourceAttr(resourceName, "location.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "location.0.address", "Brisbane, QLD"),
+					resource.TestCheckResourceAttr(resourceName, "model", "model2"),
+					resource.TestCheckResourceAttr(resourceName, "serial_number", "serial2"),
+					resource.TestCheckResourceAttrPair(resourceName, "site_id", site2ResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "type", "type2"),
+					resource.TestCheckResourceAttr(resourceName, "vendor", "vendor2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSNetworkManagerDevice_Tags(t *testing.T) {
+	resourceName := "aws_networkmanager_device.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(networkmanager.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsNetworkManagerDeviceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsNetworkManagerDeviceConfigTags1("key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsNetworkManagerDeviceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAwsNetworkManagerImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsNetworkManagerDeviceConfigTags2("key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsNetworkManagerDeviceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAwsNetworkManagerDeviceConfigTags1("key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsNetworkManagerDeviceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsNetworkManagerDeviceDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).networkmanagerconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_networkmanager_device" {
+			continue
+		}
+
+		device, err := finder.DeviceByID(conn, rs.Primary.Attributes["global_network_id"], rs.Primary.ID)
+
+		if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error reading Network Manager Device (%s): %w", rs.Primary.ID, err)
+		}
+
+		if device != nil {
+			return fmt.Errorf("Network Manager Device (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsNetworkManagerDeviceExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).networkmanagerconn
+
+		device, err := finder.DeviceByID(conn, rs.Primary.Attributes["global_network_id"], rs.Primary.ID)
+
+		if err != nil {
+			return fmt.Errorf("error reading Network Manager Device (%s): %w", rs.Primary.ID, err)
+		}
+
+		if device == nil {
+			return fmt.Errorf("Network Manager Device (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAwsNetworkManagerDeviceConfig() string {
+	return `
+resource "aws_networkmanager_global_network" "test" {}
+
+resource "aws_networkmanager_device" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+}
+`
+}
+
+func testAccAwsNetworkManagerDeviceConfigAllAttributes(siteName, description, model, serialNumber, deviceType, vendor, address string) string {
+	return fmt.Sprintf(`
+resource "aws_networkmanager_global_network" "test" {}
+
+resource "aws_networkmanager_site" "test1" {
+  global_network_id = aws_networkmanager_global_network.test.id
+}
+
+resource "aws_networkmanager_site" "test2" {
+  global_network_id = aws_networkmanager_global_network.test.id
+}
+
+resource "aws_networkmanager_device" "test" {
+  description       = %[2]q
+  global_network_id = aws_networkmanager_global_network.test.id
+  model             = %[3]q
+  serial_number     = %[4]q
+  site_id           = aws_networkmanager_site.%[1]s.id
+  type              = %[5]q
+  vendor            = %[6]q
+
+  location {
+    address = %[7]q
+  }
+}
+`, siteName, description, model, serialNumber, deviceType, vendor, address)
+}
+
+func testAccAwsNetworkManagerDeviceConfigTags1(tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_networkmanager_global_network" "test" {}
+
+resource "aws_networkmanager_device" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1)
+}
+
+func testAccAwsNetworkManagerDeviceConfigTags2(tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_networkmanager_global_network" "test" {}
+
+resource "aws_networkmanager_device" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2)
+}
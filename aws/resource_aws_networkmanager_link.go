@@ -0,0 +1,297 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkmanager/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkmanager/waiter"
+)
+
+func resourceAwsNetworkManagerLink() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsNetworkManagerLinkCreate,
+		Read:   resourceAwsNetworkManagerLinkRead,
+		Update: resourceAwsNetworkManagerLinkUpdate,
+		Delete: resourceAwsNetworkManagerLinkDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsNetworkManagerLinkImport,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"bandwidth": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"download_speed": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+
+						"upload_speed": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"global_network_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"provider_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"site_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceAwsNetworkManagerLinkCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).networkmanagerconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	globalNetworkID := d.Get("global_network_id").(string)
+
+	input := &networkmanager.CreateLinkInput{
+		Bandwidth:       expandNetworkManagerBandwidth(d.Get("bandwidth").([]interface{})),
+		GlobalNetworkId: aws.String(globalNetworkID),
+		SiteId:          aws.String(d.Get("site_id").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("provider_name"); ok {
+		input.Provider = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("type"); ok {
+		input.Type = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().NetworkmanagerTags()
+	}
+
+	log.Printf("[DEBUG] Creating Network Manager Link: %s", input)
+	output, err := conn.CreateLink(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Network Manager Link in Global Network (%s): %w", globalNetworkID, err)
+	}
+
+	d.SetId(aws.StringValue(output.Link.LinkId))
+
+	if _, err := waiter.LinkCreated(conn, globalNetworkID, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Network Manager Link (%s) creation: %w", d.Id(), err)
+	}
+
+	return resourceAwsNetworkManagerLinkRead(d, meta)
+}
+
+func resourceAwsNetworkManagerLinkRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).networkmanagerconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	link, err := finder.LinkByID(conn, d.Get("global_network_id").(string), d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Network Manager Link (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Network Manager Link (%s): %w", d.Id(), err)
+	}
+
+	if link == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Network Manager Link (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] Network Manager Link (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", link.LinkArn)
+
+	if err := d.Set("bandwidth", flattenNetworkManagerBandwidth(link.Bandwidth)); err != nil {
+		return fmt.Errorf("error setting bandwidth: %w", err)
+	}
+
+	d.Set("description", link.Description)
+	d.Set("global_network_id", link.GlobalNetworkId)
+	d.Set("provider_name", link.Provider)
+	d.Set("site_id", link.SiteId)
+	d.Set("type", link.Type)
+
+	tags := keyvaluetags.NetworkmanagerKeyValueTags(link.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsNetworkManagerLinkUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).networkmanagerconn
+	globalNetworkID := d.Get("global_network_id").(string)
+
+	if d.HasChanges("bandwidth", "description", "provider_name", "type") {
+		input := &networkmanager.UpdateLinkInput{
+			Bandwidth:       expandNetworkManagerBandwidth(d.Get("bandwidth").([]interface{})),
+			Description:     aws.String(d.Get("description").(string)),
+			GlobalNetworkId: aws.String(globalNetworkID),
+			LinkId:          aws.String(d.Id()),
+			Provider:        aws.String(d.Get("provider_name").(string)),
+			Type:            aws.String(d.Get("type").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating Network Manager Link: %s", input)
+		_, err := conn.UpdateLink(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Network Manager Link (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waiter.LinkUpdated(conn, globalNetworkID, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for Network Manager Link (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.NetworkmanagerUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Network Manager Link (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsNetworkManagerLinkRead(d, meta)
+}
+
+func resourceAwsNetworkManagerLinkDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).networkmanagerconn
+	globalNetworkID := d.Get("global_network_id").(string)
+
+	log.Printf("[DEBUG] Deleting Network Manager Link (%s)", d.Id())
+	_, err := conn.DeleteLink(&networkmanager.DeleteLinkInput{
+		GlobalNetworkId: aws.String(globalNetworkID),
+		LinkId:          aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Network Manager Link (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.LinkDeleted(conn, globalNetworkID, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Network Manager Link (%s) deletion: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsNetworkManagerLinkImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	globalNetworkID, linkID, err := networkManagerParseGlobalNetworkResourceARN(d.Id(), "link")
+
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(linkID)
+	d.Set("global_network_id", globalNetworkID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandNetworkManagerBandwidth(l []interface{}) *networkmanager.Bandwidth {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	bandwidth := &networkmanager.Bandwidth{}
+
+	if v, ok := m["download_speed"].(int); ok && v > 0 {
+		bandwidth.DownloadSpeed = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["upload_speed"].(int); ok && v > 0 {
+		bandwidth.UploadSpeed = aws.Int64(int64(v))
+	}
+
+	return bandwidth
+}
+
+func flattenNetworkManagerBandwidth(bandwidth *networkmanager.Bandwidth) []interface{} {
+	if bandwidth == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"download_speed": aws.Int64Value(bandwidth.DownloadSpeed),
+		"upload_speed":   aws.Int64Value(bandwidth.UploadSpeed),
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,155 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfnetworkmanager "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkmanager"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkmanager/finder"
+)
+
+func TestAccAWSNetworkManagerLinkAssociation_basic(t *testing.T) {
+	resourceName := "aws_networkmanager_link_association.test"
+	deviceResourceName := "aws_networkmanager_device.test"
+	globalNetworkResourceName := "aws_networkmanager_global_network.test"
+	linkResourceName := "aws_networkmanager_link.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(networkmanager.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsNetworkManagerLinkAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsNetworkManagerLinkAssociationConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsNetworkManagerLinkAssociationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "device_id", deviceResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "global_network_id", globalNetworkResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "link_id", linkResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSNetworkManagerLinkAssociation_disappears(t *testing.T) {
+	resourceName := "aws_networkmanager_link_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(networkmanager.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsNetworkManagerLinkAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsNetworkManagerLinkAssociationConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsNetworkManagerLinkAssociationExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsNetworkManagerLinkAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsNetworkManagerLinkAssociationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).networkmanagerconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_networkmanager_link_association" {
+			continue
+		}
+
+		globalNetworkID, linkID, deviceID, err := tfnetworkmanager.LinkAssociationParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		linkAssociation, err := finder.LinkAssociation(conn, globalNetworkID, linkID, deviceID)
+
+		if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error reading Network Manager Link Association (%s): %w", rs.Primary.ID, err)
+		}
+
+		if linkAssociation != nil {
+			return fmt.Errorf("Network Manager Link Association (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsNetworkManagerLinkAssociationExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).networkmanagerconn
+
+		globalNetworkID, linkID, deviceID, err := tfnetworkmanager.LinkAssociationParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		linkAssociation, err := finder.LinkAssociation(conn, globalNetworkID, linkID, deviceID)
+
+		if err != nil {
+			return fmt.Errorf("error reading Network Manager Link Association (%s): %w", rs.Primary.ID, err)
+		}
+
+		if linkAssociation == nil {
+			return fmt.Errorf("Network Manager Link Association (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAwsNetworkManagerLinkAssociationConfig() string {
+	return `
+resource "aws_networkmanager_global_network" "test" {}
+
+resource "aws_networkmanager_site" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+}
+
+resource "aws_networkmanager_device" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+  site_id           = aws_networkmanager_site.test.id
+}
+
+resource "aws_networkmanager_link" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+  site_id           = aws_networkmanager_site.test.id
+
+  bandwidth {
+    download_speed = 50
+    upload_speed   = 10
+  }
+}
+
+resource "aws_networkmanager_link_association" "test" {
+  device_id         = aws_networkmanager_device.test.id
+  global_network_id = aws_networkmanager_global_network.test.id
+  link_id           = aws_networkmanager_link.test.id
+}
+`
+}
@@ -0,0 +1,290 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkmanager/finder"
+)
+
+func TestAccAWSNetworkManagerLink_basic(t *testing.T) {
+	resourceName := "aws_networkmanager_link.test"
+	globalNetworkResourceName := "aws_networkmanager_global_network.test"
+	siteResourceName := "aws_networkmanager_site.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(networkmanager.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsNetworkManagerLinkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsNetworkManagerLinkConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsNetworkManagerLinkExists(resourceName),
+					testAccMatchResourceAttrGlobalARN(resourceName, "arn", "networkmanager", regexp.MustCompile(`link/global-network-.+/link-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth.0.download_speed", "50"),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth.0.upload_speed", "10"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "global_network_id", globalNetworkResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "provider_name", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "site_id", siteResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "type", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAwsNetworkManagerImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSNetworkManagerLink_disappears(t *testing.T) {
+	resourceName := "aws_networkmanager_link.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(networkmanager.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsNetworkManagerLinkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsNetworkManagerLinkConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsNetworkManagerLinkExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsNetworkManagerLink(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSNetworkManagerLink_allAttributes(t *testing.T) {
+	resourceName := "aws_networkmanager_link.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(networkmanager.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsNetworkManagerLinkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsNetworkManagerLinkConfigAllAttributes("description1", "provider1", "type1", 10, 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsNetworkManagerLinkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth.0.download_speed", "10"),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth.0.upload_speed", "20"),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttr(resourceName, "provider_name", "provider1"),
+					resource.TestCheckResourceAttr(resourceName, "type", "type1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAwsNetworkManagerImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsNetworkManagerLinkConfigAllAttributes("description2", "provider2", "type2", 30, 40),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsNetworkManagerLinkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth.0.download_speed", "30"),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth.0.upload_speed", "40"),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+					resource.TestCheckResourceAttr(resourceName, "provider_name", "provider2"),
+					resource.TestCheckResourceAttr(resourceName, "type", "type2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSNetworkManagerLink_Tags(t *testing.T) {
+	resourceName := "aws_networkmanager_link.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(networkmanager.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsNetworkManagerLinkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsNetworkManagerLinkConfigTags1("key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsNetworkManagerLinkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAwsNetworkManagerImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsNetworkManagerLinkConfigTags2("key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsNetworkManagerLinkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAwsNetworkManagerLinkConfigTags1("key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsNetworkManagerLinkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsNetworkManagerLinkDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).networkmanagerconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_networkmanager_link" {
+			continue
+		}
+
+		link, err := finder.LinkByID(conn, rs.Primary.Attributes["global_network_id"], rs.Primary.ID)
+
+		if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error reading Network Manager Link (%s): %w", rs.Primary.ID, err)
+		}
+
+		if link != nil {
+			return fmt.Errorf("Network Manager Link (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsNetworkManagerLinkExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).networkmanagerconn
+
+		link, err := finder.LinkByID(conn, rs.Primary.Attributes["global_network_id"], rs.Primary.ID)
+
+		if err != nil {
+			return fmt.Errorf("error reading Network Manager Link (%s): %w", rs.Primary.ID, err)
+		}
+
+		if link == nil {
+			return fmt.Errorf("Network Manager Link (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAwsNetworkManagerLinkConfigBase() string {
+	return `
+resource "aws_networkmanager_global_network" "test" {}
+
+resource "aws_networkmanager_site" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+}
+`
+}
+
+func testAccAwsNetworkManagerLinkConfig() string {
+	return composeConfig(
+		testAccAwsNetworkManagerLinkConfigBase(),
+		`
+resource "aws_networkmanager_link" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+  site_id           = aws_networkmanager_site.test.id
+
+  bandwidth {
+    download_speed = 50
+    upload_speed   = 10
+  }
+}
+`)
+}
+
+func testAccAwsNetworkManagerLinkConfigAllAttributes(description, providerName, linkType string, downloadSpeed, uploadSpeed int) string {
+	return composeConfig(
+		testAccAwsNetworkManagerLinkConfigBase(),
+		fmt.Sprintf(`
+resource "aws_networkmanager_link" "test" {
+  description       = %[1]q
+  global_network_id = aws_networkmanager_global_network.test.id
+  provider_name     = %[2]q
+  site_id           = aws_networkmanager_site.test.id
+  type              = %[3]q
+
+  bandwidth {
+    download_speed = %[4]d
+    upload_speed   = %[5]d
+  }
+}
+`, description, providerName, linkType, downloadSpeed, uploadSpeed))
+}
+
+func testAccAwsNetworkManagerLinkConfigTags1(tagKey1, tagValue1 string) string {
+	return composeConfig(
+		testAccAwsNetworkManagerLinkConfigBase(),
+		fmt.Sprintf(`
+resource "aws_networkmanager_link" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+  site_id           = aws_networkmanager_site.test.id
+
+  bandwidth {
+    download_speed = 50
+    upload_speed   = 10
+  }
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccAwsNetworkManagerLinkConfigTags2(tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(
+		testAccAwsNetworkManagerLinkConfigBase(),
+		fmt.Sprintf(`
+resource "aws_networkmanager_link" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+  site_id           = aws_networkmanager_site.test.id
+
+  bandwidth {
+    download_speed = 50
+    upload_speed   = 10
+  }
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
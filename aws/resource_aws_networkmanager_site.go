@@ -0,0 +1,308 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkmanager/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkmanager/waiter"
+)
+
+func resourceAwsNetworkManagerSite() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsNetworkManagerSiteCreate,
+		Read:   resourceAwsNetworkManagerSiteRead,
+		Update: resourceAwsNetworkManagerSiteUpdate,
+		Delete: resourceAwsNetworkManagerSiteDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsNetworkManagerSiteImport,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"global_network_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"location": networkManagerLocationSchema(),
+
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+		},
+	}
+}
+
+func resourceAwsNetworkManagerSiteCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).networkmanagerconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	globalNetworkID := d.Get("global_network_id").(string)
+
+	input := &networkmanager.CreateSiteInput{
+		GlobalNetworkId: aws.String(globalNetworkID),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("location"); ok {
+		input.Location = expandNetworkManagerLocation(v.([]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().NetworkmanagerTags()
+	}
+
+	log.Printf("[DEBUG] Creating Network Manager Site: %s", input)
+	output, err := conn.CreateSite(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Network Manager Site in Global Network (%s): %w", globalNetworkID, err)
+	}
+
+	d.SetId(aws.StringValue(output.Site.SiteId))
+
+	if _, err := waiter.SiteCreated(conn, globalNetworkID, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Network Manager Site (%s) creation: %w", d.Id(), err)
+	}
+
+	return resourceAwsNetworkManagerSiteRead(d, meta)
+}
+
+func resourceAwsNetworkManagerSiteRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).networkmanagerconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	site, err := finder.SiteByID(conn, d.Get("global_network_id").(string), d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Network Manager Site (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Network Manager Site (%s): %w", d.Id(), err)
+	}
+
+	if site == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Network Manager Site (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] Network Manager Site (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", site.SiteArn)
+	d.Set("description", site.Description)
+	d.Set("global_network_id", site.GlobalNetworkId)
+
+	if err := d.Set("location", flattenNetworkManagerLocation(site.Location)); err != nil {
+		return fmt.Errorf("error setting location: %w", err)
+	}
+
+	tags := keyvaluetags.NetworkmanagerKeyValueTags(site.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsNetworkManagerSiteUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).networkmanagerconn
+	globalNetworkID := d.Get("global_network_id").(string)
+
+	if d.HasChanges("description", "location") {
+		input := &networkmanager.UpdateSiteInput{
+			Description:     aws.String(d.Get("description").(string)),
+			GlobalNetworkId: aws.String(globalNetworkID),
+			Location:        expandNetworkManagerLocation(d.Get("location").([]interface{})),
+			SiteId:          aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating Network Manager Site: %s", input)
+		_, err := conn.UpdateSite(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Network Manager Site (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waiter.SiteUpdated(conn, globalNetworkID, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for Network Manager Site (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.NetworkmanagerUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Network Manager Site (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsNetworkManagerSiteRead(d, meta)
+}
+
+func resourceAwsNetworkManagerSiteDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).networkmanagerconn
+	globalNetworkID := d.Get("global_network_id").(string)
+
+	log.Printf("[DEBUG] Deleting Network Manager Site (%s)", d.Id())
+	_, err := conn.DeleteSite(&networkmanager.DeleteSiteInput{
+		GlobalNetworkId: aws.String(globalNetworkID),
+		SiteId:          aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Network Manager Site (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.SiteDeleted(conn, globalNetworkID, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Network Manager Site (%s) deletion: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsNetworkManagerSiteImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	globalNetworkID, siteID, err := networkManagerParseGlobalNetworkResourceARN(d.Id(), "site")
+
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(siteID)
+	d.Set("global_network_id", globalNetworkID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// networkManagerParseGlobalNetworkResourceARN returns the Global Network ID and resource ID
+// from the ARN of a resource within a Global Network, e.g.
+// arn:aws:networkmanager::123456789012:site/global-network-01231231231231231/site-444555aaabbb11223.
+func networkManagerParseGlobalNetworkResourceARN(v, resourceType string) (string, string, error) {
+	parsedARN, err := arn.Parse(v)
+
+	if err != nil {
+		return "", "", fmt.Errorf("error parsing ARN (%s): %w", v, err)
+	}
+
+	parts := strings.Split(parsedARN.Resource, "/")
+
+	if len(parts) != 3 || parts[0] != resourceType || parts[1] == "" || parts[2] == "" {
+		return "", "", fmt.Errorf("unexpected format of ARN (%s), expected arn:PARTITION:networkmanager::ACCOUNT-ID:%s/GLOBAL-NETWORK-ID/ID", v, resourceType)
+	}
+
+	return parts[1], parts[2], nil
+}
+
+func networkManagerLocationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"address": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+
+				"latitude": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+
+				"longitude": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func expandNetworkManagerLocation(l []interface{}) *networkmanager.Location {
+	if len(l) == 0 || l[0] == nil {
+		return &networkmanager.Location{}
+	}
+
+	m := l[0].(map[string]interface{})
+
+	location := &networkmanager.Location{}
+
+	if v, ok := m["address"].(string); ok {
+		location.Address = aws.String(v)
+	}
+
+	if v, ok := m["latitude"].(string); ok {
+		location.Latitude = aws.String(v)
+	}
+
+	if v, ok := m["longitude"].(string); ok {
+		location.Longitude = aws.String(v)
+	}
+
+	return location
+}
+
+func flattenNetworkManagerLocation(location *networkmanager.Location) []interface{} {
+	if location == nil {
+		return []interface{}{}
+	}
+
+	if aws.StringValue(location.Address) == "" && aws.StringValue(location.Latitude) == "" && aws.StringValue(location.Longitude) == "" {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"address":   aws.StringValue(location.Address),
+		"latitude":  aws.StringValue(location.Latitude),
+		"longitude": aws.StringValue(location.Longitude),
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,141 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tfnetworkmanager "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkmanager"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkmanager/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkmanager/waiter"
+)
+
+func resourceAwsNetworkManagerTransitGatewayRegistration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsNetworkManagerTransitGatewayRegistrationCreate,
+		Read:   resourceAwsNetworkManagerTransitGatewayRegistrationRead,
+		Delete: resourceAwsNetworkManagerTransitGatewayRegistrationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"global_network_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"transit_gateway_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+		},
+	}
+}
+
+func resourceAwsNetworkManagerTransitGatewayRegistrationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).networkmanagerconn
+
+	globalNetworkID := d.Get("global_network_id").(string)
+	transitGatewayARN := d.Get("transit_gateway_arn").(string)
+	id := tfnetworkmanager.TransitGatewayRegistrationCreateID(globalNetworkID, transitGatewayARN)
+
+	input := &networkmanager.RegisterTransitGatewayInput{
+		GlobalNetworkId:   aws.String(globalNetworkID),
+		TransitGatewayArn: aws.String(transitGatewayARN),
+	}
+
+	log.Printf("[DEBUG] Creating Network Manager Transit Gateway Registration: %s", input)
+	_, err := conn.RegisterTransitGateway(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Network Manager Transit Gateway Registration (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waiter.TransitGatewayRegistrationCreated(conn, globalNetworkID, transitGatewayARN, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Network Manager Transit Gateway Registration (%s) creation: %w", d.Id(), err)
+	}
+
+	return resourceAwsNetworkManagerTransitGatewayRegistrationRead(d, meta)
+}
+
+func resourceAwsNetworkManagerTransitGatewayRegistrationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).networkmanagerconn
+
+	globalNetworkID, transitGatewayARN, err := tfnetworkmanager.TransitGatewayRegistrationParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	transitGatewayRegistration, err := finder.TransitGatewayRegistration(conn, globalNetworkID, transitGatewayARN)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Network Manager Transit Gateway Registration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Network Manager Transit Gateway Registration (%s): %w", d.Id(), err)
+	}
+
+	if transitGatewayRegistration == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Network Manager Transit Gateway Registration (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] Network Manager Transit Gateway Registration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("global_network_id", transitGatewayRegistration.GlobalNetworkId)
+	d.Set("transit_gateway_arn", transitGatewayRegistration.TransitGatewayArn)
+
+	return nil
+}
+
+func resourceAwsNetworkManagerTransitGatewayRegistrationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).networkmanagerconn
+
+	globalNetworkID, transitGatewayARN, err := tfnetworkmanager.TransitGatewayRegistrationParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Network Manager Transit Gateway Registration (%s)", d.Id())
+	_, err = conn.DeregisterTransitGateway(&networkmanager.DeregisterTransitGatewayInput{
+		GlobalNetworkId:   aws.String(globalNetworkID),
+		TransitGatewayArn: aws.String(transitGatewayARN),
+	})
+
+	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Network Manager Transit Gateway Registration (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.TransitGatewayRegistrationDeleted(conn, globalNetworkID, transitGatewayARN, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Network Manager Transit Gateway Registration (%s) deletion: %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,150 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfnetworkmanager "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkmanager"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkmanager/finder"
+)
+
+func TestAccAWSNetworkManagerTransitGatewayRegistration_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_networkmanager_transit_gateway_registration.test"
+	globalNetworkResourceName := "aws_networkmanager_global_network.test"
+	transitGatewayResourceName := "aws_ec2_transit_gateway.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPartitionHasServicePreCheck(networkmanager.EndpointsID, t)
+			testAccPreCheckAWSEc2TransitGateway(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsNetworkManagerTransitGatewayRegistrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsNetworkManagerTransitGatewayRegistrationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsNetworkManagerTransitGatewayRegistrationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "global_network_id", globalNetworkResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_arn", transitGatewayResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSNetworkManagerTransitGatewayRegistration_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_networkmanager_transit_gateway_registration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPartitionHasServicePreCheck(networkmanager.EndpointsID, t)
+			testAccPreCheckAWSEc2TransitGateway(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsNetworkManagerTransitGatewayRegistrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsNetworkManagerTransitGatewayRegistrationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsNetworkManagerTransitGatewayRegistrationExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsNetworkManagerTransitGatewayRegistration(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsNetworkManagerTransitGatewayRegistrationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).networkmanagerconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_networkmanager_transit_gateway_registration" {
+			continue
+		}
+
+		globalNetworkID, transitGatewayARN, err := tfnetworkmanager.TransitGatewayRegistrationParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		transitGatewayRegistration, err := finder.TransitGatewayRegistration(conn, globalNetworkID, transitGatewayARN)
+
+		if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error reading Network Manager Transit Gateway Registration (%s): %w", rs.Primary.ID, err)
+		}
+
+		if transitGatewayRegistration != nil {
+			return fmt.Errorf("Network Manager Transit Gateway Registration (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsNetworkManagerTransitGatewayRegistrationExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).networkmanagerconn
+
+		globalNetworkID, transitGatewayARN, err := tfnetworkmanager.TransitGatewayRegistrationParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		transitGatewayRegistration, err := finder.TransitGatewayRegistration(conn, globalNetworkID, transitGatewayARN)
+
+		if err != nil {
+			return fmt.Errorf("error reading Network Manager Transit Gateway Registration (%s): %w", rs.Primary.ID, err)
+		}
+
+		if transitGatewayRegistration == nil {
+			return fmt.Errorf("Network Manager Transit Gateway Registration (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAwsNetworkManagerTransitGatewayRegistrationConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_networkmanager_global_network" "test" {}
+
+resource "aws_ec2_transit_gateway" "test" {
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_networkmanager_transit_gateway_registration" "test" {
+  global_network_id   = aws_networkmanager_global_network.test.id
+  transit_gateway_arn = aws_ec2_transit_gateway.test.arn
+}
+`, rName)
+}
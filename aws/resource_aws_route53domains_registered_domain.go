@@ -0,0 +1,804 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53domains"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/route53domains/waiter"
+)
+
+const (
+	route53DomainsStatusClientTransferProhibited = "clientTransferProhibited"
+)
+
+func resourceAwsRoute53DomainsRegisteredDomain() *schema.Resource {
+	contactSchema := &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"address_line_1": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(0, 255),
+				},
+				"address_line_2": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(0, 255),
+				},
+				"city": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(0, 255),
+				},
+				"contact_type": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringInSlice(route53domains.ContactType_Values(), false),
+				},
+				"country_code": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringInSlice(route53domains.CountryCode_Values(), false),
+				},
+				"email": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(0, 254),
+				},
+				"extra_params": {
+					Type:     schema.TypeMap,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"fax": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(0, 30),
+				},
+				"first_name": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(0, 255),
+				},
+				"last_name": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(0, 255),
+				},
+				"organization_name": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(0, 255),
+				},
+				"phone_number": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(0, 30),
+				},
+				"state": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(0, 255),
+				},
+				"zip_code": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(0, 255),
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Create: resourceAwsRoute53DomainsRegisteredDomainCreate,
+		Read:   resourceAwsRoute53DomainsRegisteredDomainRead,
+		Update: resourceAwsRoute53DomainsRegisteredDomainUpdate,
+		Delete: resourceAwsRoute53DomainsRegisteredDomainDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"abuse_contact_email": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"abuse_contact_phone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"admin_contact": contactSchema,
+
+			"admin_privacy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"auto_renew": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"domain_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"expiration_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"name_server": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 6,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"glue_ips": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 2,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.IsIPAddress,
+							},
+						},
+
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(0, 255),
+						},
+					},
+				},
+			},
+
+			"registrant_contact": contactSchema,
+
+			"registrant_privacy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"registrar_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"registrar_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"reseller": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status_list": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+
+			"tech_contact": contactSchema,
+
+			"tech_privacy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"transfer_lock": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"updated_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"whois_server": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsRoute53DomainsRegisteredDomainCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).route53domainsconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	// The domain must already be registered; the resource adopts it rather than registering it.
+	domainName := d.Get("domain_name").(string)
+
+	domainDetail, err := conn.GetDomainDetail(&route53domains.GetDomainDetailInput{
+		DomainName: aws.String(domainName),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading Route 53 Domains Domain (%s): %w", domainName, err)
+	}
+
+	d.SetId(aws.StringValue(domainDetail.DomainName))
+
+	timeout := d.Timeout(schema.TimeoutCreate)
+
+	var adminContact, registrantContact, techContact *route53domains.ContactDetail
+
+	if v, ok := d.GetOk("admin_contact"); ok {
+		if contact := expandRoute53DomainsContactDetail(v.([]interface{})); !reflect.DeepEqual(contact, normalizeRoute53DomainsContactDetail(domainDetail.AdminContact)) {
+			adminContact = contact
+		}
+	}
+
+	if v, ok := d.GetOk("registrant_contact"); ok {
+		if contact := expandRoute53DomainsContactDetail(v.([]interface{})); !reflect.DeepEqual(contact, normalizeRoute53DomainsContactDetail(domainDetail.RegistrantContact)) {
+			registrantContact = contact
+		}
+	}
+
+	if v, ok := d.GetOk("tech_contact"); ok {
+		if contact := expandRoute53DomainsContactDetail(v.([]interface{})); !reflect.DeepEqual(contact, normalizeRoute53DomainsContactDetail(domainDetail.TechContact)) {
+			techContact = contact
+		}
+	}
+
+	if adminContact != nil || registrantContact != nil || techContact != nil {
+		if err := modifyRoute53DomainsDomainContact(conn, d.Id(), adminContact, registrantContact, techContact, timeout); err != nil {
+			return err
+		}
+	}
+
+	if adminPrivacy, registrantPrivacy, techPrivacy := d.Get("admin_privacy").(bool), d.Get("registrant_privacy").(bool), d.Get("tech_privacy").(bool); adminPrivacy != aws.BoolValue(domainDetail.AdminPrivacy) || registrantPrivacy != aws.BoolValue(domainDetail.RegistrantPrivacy) || techPrivacy != aws.BoolValue(domainDetail.TechPrivacy) {
+		if err := modifyRoute53DomainsDomainContactPrivacy(conn, d.Id(), adminPrivacy, registrantPrivacy, techPrivacy, timeout); err != nil {
+			return err
+		}
+	}
+
+	if v := d.Get("auto_renew").(bool); v != aws.BoolValue(domainDetail.AutoRenew) {
+		if err := modifyRoute53DomainsDomainAutoRenew(conn, d.Id(), v); err != nil {
+			return err
+		}
+	}
+
+	if v, ok := d.GetOk("name_server"); ok && len(v.([]interface{})) > 0 {
+		if nameservers := expandRoute53DomainsNameservers(v.([]interface{})); !reflect.DeepEqual(nameservers, normalizeRoute53DomainsNameservers(domainDetail.Nameservers)) {
+			if err := modifyRoute53DomainsDomainNameservers(conn, d.Id(), nameservers, timeout); err != nil {
+				return err
+			}
+		}
+	}
+
+	if v := d.Get("transfer_lock").(bool); v != hasRoute53DomainsStatus(domainDetail.StatusList, route53DomainsStatusClientTransferProhibited) {
+		if err := modifyRoute53DomainsDomainTransferLock(conn, d.Id(), v, timeout); err != nil {
+			return err
+		}
+	}
+
+	oldTags, err := keyvaluetags.Route53domainsListTags(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Route 53 Domains Domain (%s): %w", d.Id(), err)
+	}
+
+	if err := keyvaluetags.Route53domainsUpdateTags(conn, d.Id(), oldTags.IgnoreAws().Map(), tags.IgnoreAws().Map()); err != nil {
+		return fmt.Errorf("error updating Route 53 Domains Domain (%s) tags: %w", d.Id(), err)
+	}
+
+	return resourceAwsRoute53DomainsRegisteredDomainRead(d, meta)
+}
+
+func resourceAwsRoute53DomainsRegisteredDomainRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).route53domainsconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	domainDetail, err := conn.GetDomainDetail(&route53domains.GetDomainDetailInput{
+		DomainName: aws.String(d.Id()),
+	})
+
+	if !d.IsNewResource() && tfawserr.ErrMessageContains(err, route53domains.ErrCodeInvalidInput, "not found") {
+		log.Printf("[WARN] Route 53 Domains Domain (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Route 53 Domains Domain (%s): %w", d.Id(), err)
+	}
+
+	d.Set("abuse_contact_email", domainDetail.AbuseContactEmail)
+	d.Set("abuse_contact_phone", domainDetail.AbuseContactPhone)
+
+	if err := d.Set("admin_contact", flattenRoute53DomainsContactDetail(domainDetail.AdminContact)); err != nil {
+		return fmt.Errorf("error setting admin_contact: %w", err)
+	}
+
+	d.Set("admin_privacy", domainDetail.AdminPrivacy)
+	d.Set("auto_renew", domainDetail.AutoRenew)
+
+	if domainDetail.CreationDate != nil {
+		d.Set("creation_date", aws.TimeValue(domainDetail.CreationDate).Format(time.RFC3339))
+	} else {
+		d.Set("creation_date", nil)
+	}
+
+	d.Set("domain_name", domainDetail.DomainName)
+
+	if domainDetail.ExpirationDate != nil {
+		d.Set("expiration_date", aws.TimeValue(domainDetail.ExpirationDate).Format(time.RFC3339))
+	} else {
+		d.Set("expiration_date", nil)
+	}
+
+	if err := d.Set("name_server", flattenRoute53DomainsNameservers(domainDetail.Nameservers)); err != nil {
+		return fmt.Errorf("error setting name_server: %w", err)
+	}
+
+	if err := d.Set("registrant_contact", flattenRoute53DomainsContactDetail(domainDetail.RegistrantContact)); err != nil {
+		return fmt.Errorf("error setting registrant_contact: %w", err)
+	}
+
+	d.Set("registrant_privacy", domainDetail.RegistrantPrivacy)
+	d.Set("registrar_name", domainDetail.RegistrarName)
+	d.Set("registrar_url", domainDetail.RegistrarUrl)
+	d.Set("reseller", domainDetail.Reseller)
+
+	if err := d.Set("status_list", aws.StringValueSlice(domainDetail.StatusList)); err != nil {
+		return fmt.Errorf("error setting status_list: %w", err)
+	}
+
+	if err := d.Set("tech_contact", flattenRoute53DomainsContactDetail(domainDetail.TechContact)); err != nil {
+		return fmt.Errorf("error setting tech_contact: %w", err)
+	}
+
+	d.Set("tech_privacy", domainDetail.TechPrivacy)
+	d.Set("transfer_lock", hasRoute53DomainsStatus(domainDetail.StatusList, route53DomainsStatusClientTransferProhibited))
+
+	if domainDetail.UpdatedDate != nil {
+		d.Set("updated_date", aws.TimeValue(domainDetail.UpdatedDate).Format(time.RFC3339))
+	} else {
+		d.Set("updated_date", nil)
+	}
+
+	d.Set("whois_server", domainDetail.WhoIsServer)
+
+	tags, err := keyvaluetags.Route53domainsListTags(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Route 53 Domains Domain (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsRoute53DomainsRegisteredDomainUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).route53domainsconn
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+
+	if d.HasChanges("admin_contact", "registrant_contact", "tech_contact") {
+		var adminContact, registrantContact, techContact *route53domains.ContactDetail
+
+		if d.HasChange("admin_contact") {
+			adminContact = expandRoute53DomainsContactDetail(d.Get("admin_contact").([]interface{}))
+		}
+
+		if d.HasChange("registrant_contact") {
+			registrantContact = expandRoute53DomainsContactDetail(d.Get("registrant_contact").([]interface{}))
+		}
+
+		if d.HasChange("tech_contact") {
+			techContact = expandRoute53DomainsContactDetail(d.Get("tech_contact").([]interface{}))
+		}
+
+		if err := modifyRoute53DomainsDomainContact(conn, d.Id(), adminContact, registrantContact, techContact, timeout); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChanges("admin_privacy", "registrant_privacy", "tech_privacy") {
+		if err := modifyRoute53DomainsDomainContactPrivacy(conn, d.Id(), d.Get("admin_privacy").(bool), d.Get("registrant_privacy").(bool), d.Get("tech_privacy").(bool), timeout); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("auto_renew") {
+		if err := modifyRoute53DomainsDomainAutoRenew(conn, d.Id(), d.Get("auto_renew").(bool)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("name_server") {
+		if v, ok := d.GetOk("name_server"); ok && len(v.([]interface{})) > 0 {
+			if err := modifyRoute53DomainsDomainNameservers(conn, d.Id(), expandRoute53DomainsNameservers(v.([]interface{})), timeout); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.HasChange("transfer_lock") {
+		if err := modifyRoute53DomainsDomainTransferLock(conn, d.Id(), d.Get("transfer_lock").(bool), timeout); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.Route53domainsUpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating Route 53 Domains Domain (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsRoute53DomainsRegisteredDomainRead(d, meta)
+}
+
+func resourceAwsRoute53DomainsRegisteredDomainDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[WARN] Route 53 Domains Domain (%s) not deleted, removing from state", d.Id())
+
+	return nil
+}
+
+func modifyRoute53DomainsDomainAutoRenew(conn *route53domains.Route53Domains, domainName string, v bool) error {
+	if v {
+		log.Printf("[DEBUG] Enabling Route 53 Domains Domain (%s) auto-renew", domainName)
+		_, err := conn.EnableDomainAutoRenew(&route53domains.EnableDomainAutoRenewInput{
+			DomainName: aws.String(domainName),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error enabling Route 53 Domains Domain (%s) auto-renew: %w", domainName, err)
+		}
+	} else {
+		log.Printf("[DEBUG] Disabling Route 53 Domains Domain (%s) auto-renew", domainName)
+		_, err := conn.DisableDomainAutoRenew(&route53domains.DisableDomainAutoRenewInput{
+			DomainName: aws.String(domainName),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error disabling Route 53 Domains Domain (%s) auto-renew: %w", domainName, err)
+		}
+	}
+
+	return nil
+}
+
+func modifyRoute53DomainsDomainContact(conn *route53domains.Route53Domains, domainName string, adminContact, registrantContact, techContact *route53domains.ContactDetail, timeout time.Duration) error {
+	input := &route53domains.UpdateDomainContactInput{
+		AdminContact:      adminContact,
+		DomainName:        aws.String(domainName),
+		RegistrantContact: registrantContact,
+		TechContact:       techContact,
+	}
+
+	log.Printf("[DEBUG] Updating Route 53 Domains Domain (%s) contacts", domainName)
+	output, err := conn.UpdateDomainContact(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Route 53 Domains Domain (%s) contacts: %w", domainName, err)
+	}
+
+	if _, err := waiter.OperationSucceeded(conn, aws.StringValue(output.OperationId), timeout); err != nil {
+		return fmt.Errorf("error waiting for Route 53 Domains Domain (%s) contacts update: %w", domainName, err)
+	}
+
+	return nil
+}
+
+func modifyRoute53DomainsDomainContactPrivacy(conn *route53domains.Route53Domains, domainName string, adminPrivacy, registrantPrivacy, techPrivacy bool, timeout time.Duration) error {
+	input := &route53domains.UpdateDomainContactPrivacyInput{
+		AdminPrivacy:      aws.Bool(adminPrivacy),
+		DomainName:        aws.String(domainName),
+		RegistrantPrivacy: aws.Bool(registrantPrivacy),
+		TechPrivacy:       aws.Bool(techPrivacy),
+	}
+
+	log.Printf("[DEBUG] Updating Route 53 Domains Domain (%s) contact privacy: %s", domainName, input)
+	output, err := conn.UpdateDomainContactPrivacy(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Route 53 Domains Domain (%s) contact privacy: %w", domainName, err)
+	}
+
+	if _, err := waiter.OperationSucceeded(conn, aws.StringValue(output.OperationId), timeout); err != nil {
+		return fmt.Errorf("error waiting for Route 53 Domains Domain (%s) contact privacy update: %w", domainName, err)
+	}
+
+	return nil
+}
+
+func modifyRoute53DomainsDomainNameservers(conn *route53domains.Route53Domains, domainName string, nameservers []*route53domains.Nameserver, timeout time.Duration) error {
+	input := &route53domains.UpdateDomainNameserversInput{
+		DomainName:  aws.String(domainName),
+		Nameservers: nameservers,
+	}
+
+	log.Printf("[DEBUG] Updating Route 53 Domains Domain (%s) name servers: %s", domainName, input)
+	output, err := conn.UpdateDomainNameservers(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Route 53 Domains Domain (%s) name servers: %w", domainName, err)
+	}
+
+	if _, err := waiter.OperationSucceeded(conn, aws.StringValue(output.OperationId), timeout); err != nil {
+		return fmt.Errorf("error waiting for Route 53 Domains Domain (%s) name servers update: %w", domainName, err)
+	}
+
+	return nil
+}
+
+func modifyRoute53DomainsDomainTransferLock(conn *route53domains.Route53Domains, domainName string, v bool, timeout time.Duration) error {
+	var operationID string
+
+	if v {
+		log.Printf("[DEBUG] Enabling Route 53 Domains Domain (%s) transfer lock", domainName)
+		output, err := conn.EnableDomainTransferLock(&route53domains.EnableDomainTransferLockInput{
+			DomainName: aws.String(domainName),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error enabling Route 53 Domains Domain (%s) transfer lock: %w", domainName, err)
+		}
+
+		operationID = aws.StringValue(output.OperationId)
+	} else {
+		log.Printf("[DEBUG] Disabling Route 53 Domains Domain (%s) transfer lock", domainName)
+		output, err := conn.DisableDomainTransferLock(&route53domains.DisableDomainTransferLockInput{
+			DomainName: aws.String(domainName),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error disabling Route 53 Domains Domain (%s) transfer lock: %w", domainName, err)
+		}
+
+		operationID = aws.StringValue(output.OperationId)
+	}
+
+	if _, err := waiter.OperationSucceeded(conn, operationID, timeout); err != nil {
+		return fmt.Errorf("error waiting for Route 53 Domains Domain (%s) transfer lock update: %w", domainName, err)
+	}
+
+	return nil
+}
+
+func hasRoute53DomainsStatus(statusList []*string, status string) bool {
+	for _, v := range statusList {
+		if aws.StringValue(v) == status {
+			return true
+		}
+	}
+
+	return false
+}
+
+func expandRoute53DomainsContactDetail(l []interface{}) *route53domains.ContactDetail {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	contact := &route53domains.ContactDetail{}
+
+	if v, ok := m["address_line_1"].(string); ok && v != "" {
+		contact.AddressLine1 = aws.String(v)
+	}
+
+	if v, ok := m["address_line_2"].(string); ok && v != "" {
+		contact.AddressLine2 = aws.String(v)
+	}
+
+	if v, ok := m["city"].(string); ok && v != "" {
+		contact.City = aws.String(v)
+	}
+
+	if v, ok := m["contact_type"].(string); ok && v != "" {
+		contact.ContactType = aws.String(v)
+	}
+
+	if v, ok := m["country_code"].(string); ok && v != "" {
+		contact.CountryCode = aws.String(v)
+	}
+
+	if v, ok := m["email"].(string); ok && v != "" {
+		contact.Email = aws.String(v)
+	}
+
+	if v, ok := m["extra_params"].(map[string]interface{}); ok && len(v) > 0 {
+		contact.ExtraParams = expandRoute53DomainsExtraParams(v)
+	}
+
+	if v, ok := m["fax"].(string); ok && v != "" {
+		contact.Fax = aws.String(v)
+	}
+
+	if v, ok := m["first_name"].(string); ok && v != "" {
+		contact.FirstName = aws.String(v)
+	}
+
+	if v, ok := m["last_name"].(string); ok && v != "" {
+		contact.LastName = aws.String(v)
+	}
+
+	if v, ok := m["organization_name"].(string); ok && v != "" {
+		contact.OrganizationName = aws.String(v)
+	}
+
+	if v, ok := m["phone_number"].(string); ok && v != "" {
+		contact.PhoneNumber = aws.String(v)
+	}
+
+	if v, ok := m["state"].(string); ok && v != "" {
+		contact.State = aws.String(v)
+	}
+
+	if v, ok := m["zip_code"].(string); ok && v != "" {
+		contact.ZipCode = aws.String(v)
+	}
+
+	return contact
+}
+
+func expandRoute53DomainsExtraParams(m map[string]interface{}) []*route53domains.ExtraParam {
+	extraParams := make([]*route53domains.ExtraParam, 0, len(m))
+
+	for k, v := range m {
+		extraParams = append(extraParams, &route53domains.ExtraParam{
+			Name:  aws.String(k),
+			Value: aws.String(v.(string)),
+		})
+	}
+
+	sort.Slice(extraParams, func(i, j int) bool {
+		return aws.StringValue(extraParams[i].Name) < aws.StringValue(extraParams[j].Name)
+	})
+
+	return extraParams
+}
+
+func flattenRoute53DomainsContactDetail(contact *route53domains.ContactDetail) []interface{} {
+	if contact == nil {
+		return []interface{}{}
+	}
+
+	extraParams := make(map[string]interface{}, len(contact.ExtraParams))
+
+	for _, extraParam := range contact.ExtraParams {
+		if extraParam == nil {
+			continue
+		}
+
+		extraParams[aws.StringValue(extraParam.Name)] = aws.StringValue(extraParam.Value)
+	}
+
+	m := map[string]interface{}{
+		"address_line_1":    aws.StringValue(contact.AddressLine1),
+		"address_line_2":    aws.StringValue(contact.AddressLine2),
+		"city":              aws.StringValue(contact.City),
+		"contact_type":      aws.StringValue(contact.ContactType),
+		"country_code":      aws.StringValue(contact.CountryCode),
+		"email":             aws.StringValue(contact.Email),
+		"extra_params":      extraParams,
+		"fax":               aws.StringValue(contact.Fax),
+		"first_name":        aws.StringValue(contact.FirstName),
+		"last_name":         aws.StringValue(contact.LastName),
+		"organization_name": aws.StringValue(contact.OrganizationName),
+		"phone_number":      aws.StringValue(contact.PhoneNumber),
+		"state":             aws.StringValue(contact.State),
+		"zip_code":          aws.StringValue(contact.ZipCode),
+	}
+
+	return []interface{}{m}
+}
+
+// normalizeRoute53DomainsContactDetail returns the API contact details in the form produced by
+// expandRoute53DomainsContactDetail so that the two can be compared.
+func normalizeRoute53DomainsContactDetail(contact *route53domains.ContactDetail) *route53domains.ContactDetail {
+	return expandRoute53DomainsContactDetail(flattenRoute53DomainsContactDetail(contact))
+}
+
+func expandRoute53DomainsNameservers(l []interface{}) []*route53domains.Nameserver {
+	nameservers := make([]*route53domains.Nameserver, 0, len(l))
+
+	for _, tfMapRaw := range l {
+		m, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		nameserver := &route53domains.Nameserver{
+			Name: aws.String(m["name"].(string)),
+		}
+
+		if v, ok := m["glue_ips"].(*schema.Set); ok && v.Len() > 0 {
+			glueIPs := expandStringSet(v)
+
+			sort.Slice(glueIPs, func(i, j int) bool {
+				return aws.StringValue(glueIPs[i]) < aws.StringValue(glueIPs[j])
+			})
+
+			nameserver.GlueIps = glueIPs
+		}
+
+		nameservers = append(nameservers, nameserver)
+	}
+
+	return nameservers
+}
+
+func flattenRoute53DomainsNameservers(nameservers []*route53domains.Nameserver) []interface{} {
+	l := make([]interface{}, 0, len(nameservers))
+
+	for _, nameserver := range nameservers {
+		if nameserver == nil {
+			continue
+		}
+
+		l = append(l, map[string]interface{}{
+			"glue_ips": flattenStringSet(nameserver.GlueIps),
+			"name":     aws.StringValue(nameserver.Name),
+		})
+	}
+
+	return l
+}
+
+// normalizeRoute53DomainsNameservers returns the API name servers in the form produced by
+// expandRoute53DomainsNameservers so that the two can be compared.
+func normalizeRoute53DomainsNameservers(nameservers []*route53domains.Nameserver) []*route53domains.Nameserver {
+	return expandRoute53DomainsNameservers(flattenRoute53DomainsNameservers(nameservers))
+}
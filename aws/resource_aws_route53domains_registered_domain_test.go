@@ -0,0 +1,271 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/service/route53domains"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testAccPreCheckRoute53DomainsRegisteredDomain(t *testing.T) {
+	if os.Getenv("ROUTE53DOMAINS_DOMAIN_NAME") == "" {
+		t.Skip("Environment variable ROUTE53DOMAINS_DOMAIN_NAME is not set")
+	}
+
+	testAccRegionPreCheck(t, endpoints.UsEast1RegionID)
+}
+
+func TestAccAWSRoute53DomainsRegisteredDomain_basic(t *testing.T) {
+	resourceName := "aws_route53domains_registered_domain.test"
+	domainName := os.Getenv("ROUTE53DOMAINS_DOMAIN_NAME")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckRoute53DomainsRegisteredDomain(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsRoute53DomainsRegisteredDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsRoute53DomainsRegisteredDomainConfig(domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsRoute53DomainsRegisteredDomainExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "admin_contact.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "admin_privacy", "true"),
+					resource.TestCheckResourceAttr(resourceName, "auto_renew", "true"),
+					testAccCheckResourceAttrRfc3339(resourceName, "creation_date"),
+					resource.TestCheckResourceAttr(resourceName, "domain_name", domainName),
+					testAccCheckResourceAttrRfc3339(resourceName, "expiration_date"),
+					resource.TestCheckResourceAttrSet(resourceName, "name_server.#"),
+					resource.TestCheckResourceAttr(resourceName, "registrant_contact.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "registrant_privacy", "true"),
+					resource.TestCheckResourceAttr(resourceName, "tech_contact.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tech_privacy", "true"),
+					resource.TestCheckResourceAttr(resourceName, "transfer_lock", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSRoute53DomainsRegisteredDomain_AutoRenew(t *testing.T) {
+	resourceName := "aws_route53domains_registered_domain.test"
+	domainName := os.Getenv("ROUTE53DOMAINS_DOMAIN_NAME")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckRoute53DomainsRegisteredDomain(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsRoute53DomainsRegisteredDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsRoute53DomainsRegisteredDomainConfigAutoRenew(domainName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsRoute53DomainsRegisteredDomainExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_renew", "false"),
+				),
+			},
+			{
+				Config: testAccAwsRoute53DomainsRegisteredDomainConfigAutoRenew(domainName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsRoute53DomainsRegisteredDomainExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_renew", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSRoute53DomainsRegisteredDomain_ContactPrivacy(t *testing.T) {
+	resourceName := "aws_route53domains_registered_domain.test"
+	domainName := os.Getenv("ROUTE53DOMAINS_DOMAIN_NAME")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckRoute53DomainsRegisteredDomain(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsRoute53DomainsRegisteredDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsRoute53DomainsRegisteredDomainConfigContactPrivacy(domainName, true, false, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsRoute53DomainsRegisteredDomainExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "admin_privacy", "true"),
+					resource.TestCheckResourceAttr(resourceName, "registrant_privacy", "false"),
+					resource.TestCheckResourceAttr(resourceName, "tech_privacy", "true"),
+				),
+			},
+			{
+				Config: testAccAwsRoute53DomainsRegisteredDomainConfigContactPrivacy(domainName, true, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsRoute53DomainsRegisteredDomainExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "admin_privacy", "true"),
+					resource.TestCheckResourceAttr(resourceName, "registrant_privacy", "true"),
+					resource.TestCheckResourceAttr(resourceName, "tech_privacy", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSRoute53DomainsRegisteredDomain_TransferLock(t *testing.T) {
+	resourceName := "aws_route53domains_registered_domain.test"
+	domainName := os.Getenv("ROUTE53DOMAINS_DOMAIN_NAME")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckRoute53DomainsRegisteredDomain(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsRoute53DomainsRegisteredDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsRoute53DomainsRegisteredDomainConfigTransferLock(domainName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsRoute53DomainsRegisteredDomainExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "transfer_lock", "false"),
+				),
+			},
+			{
+				Config: testAccAwsRoute53DomainsRegisteredDomainConfigTransferLock(domainName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsRoute53DomainsRegisteredDomainExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "transfer_lock", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSRoute53DomainsRegisteredDomain_Tags(t *testing.T) {
+	resourceName := "aws_route53domains_registered_domain.test"
+	domainName := os.Getenv("ROUTE53DOMAINS_DOMAIN_NAME")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckRoute53DomainsRegisteredDomain(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsRoute53DomainsRegisteredDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsRoute53DomainsRegisteredDomainConfigTags1(domainName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsRoute53DomainsRegisteredDomainExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAwsRoute53DomainsRegisteredDomainConfigTags2(domainName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsRoute53DomainsRegisteredDomainExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAwsRoute53DomainsRegisteredDomainConfigTags1(domainName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsRoute53DomainsRegisteredDomainExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckAwsRoute53DomainsRegisteredDomainDestroy is a no-op as
+// removing the resource does not affect the domain registration.
+func testAccCheckAwsRoute53DomainsRegisteredDomainDestroy(s *terraform.State) error {
+	return nil
+}
+
+func testAccCheckAwsRoute53DomainsRegisteredDomainExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Route 53 Domains Domain ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).route53domainsconn
+
+		_, err := conn.GetDomainDetail(&route53domains.GetDomainDetailInput{
+			DomainName: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccAwsRoute53DomainsRegisteredDomainConfig(domainName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53domains_registered_domain" "test" {
+  domain_name = %[1]q
+}
+`, domainName)
+}
+
+func testAccAwsRoute53DomainsRegisteredDomainConfigAutoRenew(domainName string, autoRenew bool) string {
+	return fmt.Sprintf(`
+resource "aws_route53domains_registered_domain" "test" {
+  domain_name = %[1]q
+  auto_renew  = %[2]t
+}
+`, domainName, autoRenew)
+}
+
+func testAccAwsRoute53DomainsRegisteredDomainConfigContactPrivacy(domainName string, adminPrivacy, registrantPrivacy, techPrivacy bool) string {
+	return fmt.Sprintf(`
+resource "aws_route53domains_registered_domain" "test" {
+  domain_name = %[1]q
+
+  admin_privacy      = %[2]t
+  registrant_privacy = %[3]t
+  tech_privacy       = %[4]t
+}
+`, domainName, adminPrivacy, registrantPrivacy, techPrivacy)
+}
+
+func testAccAwsRoute53DomainsRegisteredDomainConfigTransferLock(domainName string, transferLock bool) string {
+	return fmt.Sprintf(`
+resource "aws_route53domains_registered_domain" "test" {
+  domain_name   = %[1]q
+  transfer_lock = %[2]t
+}
+`, domainName, transferLock)
+}
+
+func testAccAwsRoute53DomainsRegisteredDomainConfigTags1(domainName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_route53domains_registered_domain" "test" {
+  domain_name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, domainName, tagKey1, tagValue1)
+}
+
+func testAccAwsRoute53DomainsRegisteredDomainConfigTags2(domainName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_route53domains_registered_domain" "test" {
+  domain_name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, domainName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,331 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	serverlessrepository "github.com/aws/aws-sdk-go/service/serverlessapplicationrepository"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	cffinder "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudformation/finder"
+	cfwaiter "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudformation/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/serverlessapplicationrepository/finder"
+)
+
+const (
+	serverlessApplicationRepositoryCloudFormationStackNamePrefix = "serverlessrepo-"
+
+	serverlessApplicationRepositoryCloudFormationStackTagApplicationID   = "serverlessrepo:applicationId"
+	serverlessApplicationRepositoryCloudFormationStackTagSemanticVersion = "serverlessrepo:semanticVersion"
+)
+
+func resourceAwsServerlessApplicationRepositoryCloudFormationStack() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServerlessApplicationRepositoryCloudFormationStackCreate,
+		Read:   resourceAwsServerlessApplicationRepositoryCloudFormationStackRead,
+		Update: resourceAwsServerlessApplicationRepositoryCloudFormationStackUpdate,
+		Delete: resourceAwsServerlessApplicationRepositoryCloudFormationStackDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"application_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+
+			"capabilities": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(serverlessrepository.Capability_Values(), false),
+				},
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"outputs": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"semantic_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+		},
+	}
+}
+
+func resourceAwsServerlessApplicationRepositoryCloudFormationStackCreate(d *schema.ResourceData, meta interface{}) error {
+	cfConn := meta.(*AWSClient).cfconn
+
+	changeSet, err := createServerlessApplicationRepositoryCloudFormationChangeSet(d, meta)
+
+	if err != nil {
+		return fmt.Errorf("error creating Serverless Application Repository CloudFormation Stack (%s) change set: %w", d.Get("name").(string), err)
+	}
+
+	log.Printf("[DEBUG] Executing Serverless Application Repository CloudFormation Stack (%s) change set", d.Get("name").(string))
+	_, err = cfConn.ExecuteChangeSet(&cloudformation.ExecuteChangeSetInput{
+		ChangeSetName: changeSet.ChangeSetId,
+	})
+
+	if err != nil {
+		return fmt.Errorf("error executing Serverless Application Repository CloudFormation Stack (%s) change set: %w", d.Get("name").(string), err)
+	}
+
+	d.SetId(aws.StringValue(changeSet.StackId))
+
+	if _, err := cfwaiter.StackCreated(cfConn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Serverless Application Repository CloudFormation Stack (%s) creation: %w", d.Id(), err)
+	}
+
+	return resourceAwsServerlessApplicationRepositoryCloudFormationStackRead(d, meta)
+}
+
+func resourceAwsServerlessApplicationRepositoryCloudFormationStackRead(d *schema.ResourceData, meta interface{}) error {
+	serverlessConn := meta.(*AWSClient).serverlessapplicationrepositoryconn
+	cfConn := meta.(*AWSClient).cfconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	stack, err := cffinder.StackByID(cfConn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error reading Serverless Application Repository CloudFormation Stack (%s): %w", d.Id(), err)
+	}
+
+	if stack == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Serverless Application Repository CloudFormation Stack (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] Serverless Application Repository CloudFormation Stack (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	// Serverless Application Repository prefixes the CloudFormation stack name with "serverlessrepo-".
+	d.Set("name", strings.TrimPrefix(aws.StringValue(stack.StackName), serverlessApplicationRepositoryCloudFormationStackNamePrefix))
+
+	tags := keyvaluetags.CloudformationKeyValueTags(stack.Tags)
+
+	applicationID := aws.StringValue(tags.KeyValue(serverlessApplicationRepositoryCloudFormationStackTagApplicationID))
+	semanticVersion := aws.StringValue(tags.KeyValue(serverlessApplicationRepositoryCloudFormationStackTagSemanticVersion))
+
+	if applicationID == "" {
+		return fmt.Errorf("error reading Serverless Application Repository CloudFormation Stack (%s): missing tag %q", d.Id(), serverlessApplicationRepositoryCloudFormationStackTagApplicationID)
+	}
+
+	d.Set("application_id", applicationID)
+	d.Set("semantic_version", semanticVersion)
+
+	application, err := finder.Application(serverlessConn, applicationID, semanticVersion)
+
+	if err != nil {
+		return fmt.Errorf("error reading Serverless Application Repository application (%s): %w", applicationID, err)
+	}
+
+	var requiredCapabilities []*string
+
+	if application != nil && application.Version != nil {
+		requiredCapabilities = application.Version.RequiredCapabilities
+	}
+
+	if err := d.Set("capabilities", flattenServerlessApplicationRepositoryStackCapabilities(stack.Capabilities, requiredCapabilities)); err != nil {
+		return fmt.Errorf("error setting capabilities: %w", err)
+	}
+
+	if err := d.Set("outputs", flattenCloudFormationOutputs(stack.Outputs)); err != nil {
+		return fmt.Errorf("error setting outputs: %w", err)
+	}
+
+	if err := d.Set("parameters", flattenServerlessApplicationRepositoryStackParameters(stack.Parameters, d.Get("parameters").(map[string]interface{}))); err != nil {
+		return fmt.Errorf("error setting parameters: %w", err)
+	}
+
+	tags = tags.IgnoreServerlessApplicationRepository().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsServerlessApplicationRepositoryCloudFormationStackUpdate(d *schema.ResourceData, meta interface{}) error {
+	cfConn := meta.(*AWSClient).cfconn
+
+	changeSet, err := createServerlessApplicationRepositoryCloudFormationChangeSet(d, meta)
+
+	if err != nil {
+		return fmt.Errorf("error creating Serverless Application Repository CloudFormation Stack (%s) change set: %w", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Executing Serverless Application Repository CloudFormation Stack (%s) change set", d.Id())
+	_, err = cfConn.ExecuteChangeSet(&cloudformation.ExecuteChangeSetInput{
+		ChangeSetName: changeSet.ChangeSetId,
+	})
+
+	if err != nil {
+		return fmt.Errorf("error executing Serverless Application Repository CloudFormation Stack (%s) change set: %w", d.Id(), err)
+	}
+
+	if _, err := cfwaiter.StackUpdated(cfConn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("error waiting for Serverless Application Repository CloudFormation Stack (%s) update: %w", d.Id(), err)
+	}
+
+	return resourceAwsServerlessApplicationRepositoryCloudFormationStackRead(d, meta)
+}
+
+func resourceAwsServerlessApplicationRepositoryCloudFormationStackDelete(d *schema.ResourceData, meta interface{}) error {
+	cfConn := meta.(*AWSClient).cfconn
+
+	log.Printf("[DEBUG] Deleting Serverless Application Repository CloudFormation Stack (%s)", d.Id())
+	_, err := cfConn.DeleteStack(&cloudformation.DeleteStackInput{
+		StackName: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error deleting Serverless Application Repository CloudFormation Stack (%s): %w", d.Id(), err)
+	}
+
+	if _, err := cfwaiter.StackDeleted(cfConn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Serverless Application Repository CloudFormation Stack (%s) deletion: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// createServerlessApplicationRepositoryCloudFormationChangeSet creates a change set for the stack
+// and waits for it to be ready for execution.
+func createServerlessApplicationRepositoryCloudFormationChangeSet(d *schema.ResourceData, meta interface{}) (*cloudformation.DescribeChangeSetOutput, error) {
+	serverlessConn := meta.(*AWSClient).serverlessapplicationrepositoryconn
+	cfConn := meta.(*AWSClient).cfconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &serverlessrepository.CreateCloudFormationChangeSetRequest{
+		ApplicationId: aws.String(d.Get("application_id").(string)),
+		Capabilities:  expandStringSet(d.Get("capabilities").(*schema.Set)),
+		StackName:     aws.String(d.Get("name").(string)),
+	}
+
+	if v, ok := d.GetOk("parameters"); ok && len(v.(map[string]interface{})) > 0 {
+		input.ParameterOverrides = expandServerlessApplicationRepositoryChangeSetParameters(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("semantic_version"); ok {
+		input.SemanticVersion = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().ServerlessapplicationrepositoryTags()
+	}
+
+	log.Printf("[DEBUG] Creating Serverless Application Repository CloudFormation change set: %s", input)
+	output, err := serverlessConn.CreateCloudFormationChangeSet(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	changeSet, err := cfwaiter.ChangeSetCreated(cfConn, aws.StringValue(output.StackId), aws.StringValue(output.ChangeSetId))
+
+	if err != nil {
+		return nil, fmt.Errorf("error waiting for change set (%s) creation: %w", aws.StringValue(output.ChangeSetId), err)
+	}
+
+	return changeSet, nil
+}
+
+func expandServerlessApplicationRepositoryChangeSetParameters(params map[string]interface{}) []*serverlessrepository.ParameterValue {
+	var parameterValues []*serverlessrepository.ParameterValue
+
+	for k, v := range params {
+		parameterValues = append(parameterValues, &serverlessrepository.ParameterValue{
+			Name:  aws.String(k),
+			Value: aws.String(v.(string)),
+		})
+	}
+
+	return parameterValues
+}
+
+// flattenServerlessApplicationRepositoryStackCapabilities adds the capabilities that CloudFormation
+// does not report but that the Serverless Application Repository application requires.
+func flattenServerlessApplicationRepositoryStackCapabilities(stackCapabilities []*string, applicationRequiredCapabilities []*string) *schema.Set {
+	capabilities := flattenStringSet(stackCapabilities)
+
+	for _, capability := range applicationRequiredCapabilities {
+		if aws.StringValue(capability) == serverlessrepository.CapabilityCapabilityResourcePolicy {
+			capabilities.Add(serverlessrepository.CapabilityCapabilityResourcePolicy)
+			break
+		}
+	}
+
+	return capabilities
+}
+
+// flattenServerlessApplicationRepositoryStackParameters returns the stack parameters,
+// keeping any configured value for parameters whose values CloudFormation masks.
+func flattenServerlessApplicationRepositoryStackParameters(stackParameters []*cloudformation.Parameter, configuredParameters map[string]interface{}) map[string]interface{} {
+	params := make(map[string]interface{}, len(stackParameters))
+
+	for _, p := range stackParameters {
+		key := aws.StringValue(p.ParameterKey)
+		value := aws.StringValue(p.ParameterValue)
+
+		// NoEcho parameter values are returned as "****".
+		if value == "****" {
+			if v, ok := configuredParameters[key]; ok {
+				params[key] = v
+			}
+
+			continue
+		}
+
+		params[key] = value
+	}
+
+	return params
+}
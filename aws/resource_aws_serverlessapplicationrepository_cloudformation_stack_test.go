@@ -0,0 +1,473 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	serverlessrepository "github.com/aws/aws-sdk-go/service/serverlessapplicationrepository"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	cffinder "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudformation/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfawsresource"
+)
+
+// Since aws_serverlessapplicationrepository_cloudformation_stack creates CloudFormation stacks,
+// the aws_cloudformation_stack sweeper will clean these up as well.
+
+func TestAccAwsServerlessApplicationRepositoryCloudFormationStack_basic(t *testing.T) {
+	var stack cloudformation.Stack
+	stackName := acctest.RandomWithPrefix("tf-acc-test")
+	appARN := testAccAwsServerlessApplicationRepositoryCloudFormationApplicationID()
+	resourceName := "aws_serverlessapplicationrepository_cloudformation_stack.postgres-rotator"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(serverlessrepository.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSServerlessApplicationRepositoryCloudFormationStackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServerlessApplicationRepositoryCloudFormationStackConfig(stackName, appARN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServerlessApplicationRepositoryCloudFormationStackExists(resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "name", stackName),
+					resource.TestCheckResourceAttr(resourceName, "application_id", appARN),
+					resource.TestCheckResourceAttrSet(resourceName, "semantic_version"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.functionName", fmt.Sprintf("func-%s", stackName)),
+					resource.TestCheckResourceAttr(resourceName, "parameters.endpoint", fmt.Sprintf("secretsmanager.%s.%s", testAccGetRegion(), testAccGetPartitionDNSSuffix())),
+					resource.TestCheckResourceAttr(resourceName, "outputs.%", "1"),
+					testAccCheckResourceAttrRegionalARN(resourceName, "outputs.RotationLambdaARN", "lambda", fmt.Sprintf("function:func-%s", stackName)),
+					resource.TestCheckResourceAttr(resourceName, "capabilities.#", "2"),
+					tfawsresource.TestCheckTypeSetElemAttr(resourceName, "capabilities.*", serverlessrepository.CapabilityCapabilityIam),
+					tfawsresource.TestCheckTypeSetElemAttr(resourceName, "capabilities.*", serverlessrepository.CapabilityCapabilityResourcePolicy),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAwsServerlessApplicationRepositoryCloudFormationStack_disappears(t *testing.T) {
+	var stack cloudformation.Stack
+	stackName := acctest.RandomWithPrefix("tf-acc-test")
+	appARN := testAccAwsServerlessApplicationRepositoryCloudFormationApplicationID()
+	resourceName := "aws_serverlessapplicationrepository_cloudformation_stack.postgres-rotator"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(serverlessrepository.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSServerlessApplicationRepositoryCloudFormationStackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServerlessApplicationRepositoryCloudFormationStackConfig(stackName, appARN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServerlessApplicationRepositoryCloudFormationStackExists(resourceName, &stack),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsServerlessApplicationRepositoryCloudFormationStack(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAwsServerlessApplicationRepositoryCloudFormationStack_versioned(t *testing.T) {
+	var stack1, stack2, stack3 cloudformation.Stack
+	stackName := acctest.RandomWithPrefix("tf-acc-test")
+	appARN := testAccAwsServerlessApplicationRepositoryCloudFormationApplicationID()
+	resourceName := "aws_serverlessapplicationrepository_cloudformation_stack.postgres-rotator"
+
+	const (
+		version1 = "1.0.13"
+		version2 = "1.1.36"
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(serverlessrepository.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSServerlessApplicationRepositoryCloudFormationStackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServerlessApplicationRepositoryCloudFormationStackConfigVersioned(stackName, appARN, version1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServerlessApplicationRepositoryCloudFormationStackExists(resourceName, &stack1),
+					resource.TestCheckResourceAttr(resourceName, "semantic_version", version1),
+					resource.TestCheckResourceAttr(resourceName, "capabilities.#", "1"),
+					tfawsresource.TestCheckTypeSetElemAttr(resourceName, "capabilities.*", serverlessrepository.CapabilityCapabilityIam),
+				),
+			},
+			{
+				Config: testAccAWSServerlessApplicationRepositoryCloudFormationStackConfigVersioned2(stackName, appARN, version2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServerlessApplicationRepositoryCloudFormationStackExists(resourceName, &stack2),
+					testAccCheckCloudFormationStackNotRecreated(&stack1, &stack2),
+					resource.TestCheckResourceAttr(resourceName, "semantic_version", version2),
+					resource.TestCheckResourceAttr(resourceName, "capabilities.#", "2"),
+					tfawsresource.TestCheckTypeSetElemAttr(resourceName, "capabilities.*", serverlessrepository.CapabilityCapabilityIam),
+					tfawsresource.TestCheckTypeSetElemAttr(resourceName, "capabilities.*", serverlessrepository.CapabilityCapabilityResourcePolicy),
+				),
+			},
+			{
+				// Confirm removal of "CAPABILITY_RESOURCE_POLICY" is handled properly
+				Config: testAccAWSServerlessApplicationRepositoryCloudFormationStackConfigVersioned(stackName, appARN, version1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServerlessApplicationRepositoryCloudFormationStackExists(resourceName, &stack3),
+					testAccCheckCloudFormationStackNotRecreated(&stack2, &stack3),
+					resource.TestCheckResourceAttr(resourceName, "semantic_version", version1),
+					resource.TestCheckResourceAttr(resourceName, "capabilities.#", "1"),
+					tfawsresource.TestCheckTypeSetElemAttr(resourceName, "capabilities.*", serverlessrepository.CapabilityCapabilityIam),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAwsServerlessApplicationRepositoryCloudFormationStack_Tags(t *testing.T) {
+	var stack cloudformation.Stack
+	stackName := acctest.RandomWithPrefix("tf-acc-test")
+	appARN := testAccAwsServerlessApplicationRepositoryCloudFormationApplicationID()
+	resourceName := "aws_serverlessapplicationrepository_cloudformation_stack.postgres-rotator"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(serverlessrepository.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSServerlessApplicationRepositoryCloudFormationStackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServerlessApplicationRepositoryCloudFormationStackConfigTags1(stackName, appARN, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServerlessApplicationRepositoryCloudFormationStackExists(resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSServerlessApplicationRepositoryCloudFormationStackConfigTags2(stackName, appARN, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServerlessApplicationRepositoryCloudFormationStackExists(resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSServerlessApplicationRepositoryCloudFormationStackConfigTags1(stackName, appARN, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServerlessApplicationRepositoryCloudFormationStackExists(resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAwsServerlessApplicationRepositoryCloudFormationStack_update(t *testing.T) {
+	var stack cloudformation.Stack
+	stackName := acctest.RandomWithPrefix("tf-acc-test")
+	initialName := acctest.RandomWithPrefix("FuncName1")
+	updatedName := acctest.RandomWithPrefix("FuncName2")
+	appARN := testAccAwsServerlessApplicationRepositoryCloudFormationApplicationID()
+	resourceName := "aws_serverlessapplicationrepository_cloudformation_stack.postgres-rotator"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(serverlessrepository.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSServerlessApplicationRepositoryCloudFormationStackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServerlessApplicationRepositoryCloudFormationStackConfigUpdateInitial(stackName, appARN, initialName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServerlessApplicationRepositoryCloudFormationStackExists(resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "application_id", appARN),
+					resource.TestCheckResourceAttr(resourceName, "parameters.functionName", initialName),
+					resource.TestCheckResourceAttr(resourceName, "tags.ToUpdate", "InitialValue"),
+				),
+			},
+			{
+				Config: testAccAWSServerlessApplicationRepositoryCloudFormationStackConfigUpdated(stackName, appARN, updatedName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServerlessApplicationRepositoryCloudFormationStackExists(resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "parameters.functionName", updatedName),
+					resource.TestCheckResourceAttr(resourceName, "tags.ToUpdate", "UpdatedValue"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckServerlessApplicationRepositoryCloudFormationStackExists(resourceName string, v *cloudformation.Stack) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Serverless Application Repository CloudFormation Stack ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cfconn
+
+		stack, err := cffinder.StackByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if stack == nil {
+			return fmt.Errorf("Serverless Application Repository CloudFormation Stack (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *stack
+
+		return nil
+	}
+}
+
+func testAccCheckAWSServerlessApplicationRepositoryCloudFormationStackDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cfconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_serverlessapplicationrepository_cloudformation_stack" {
+			continue
+		}
+
+		stack, err := cffinder.StackByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if stack != nil {
+			return fmt.Errorf("Serverless Application Repository CloudFormation Stack (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckCloudFormationStackNotRecreated(i, j *cloudformation.Stack) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(i.StackId) != aws.StringValue(j.StackId) {
+			return fmt.Errorf("CloudFormation stack recreated")
+		}
+
+		return nil
+	}
+}
+
+// testAccAwsServerlessApplicationRepositoryCloudFormationApplicationID returns the ID of the
+// public "SecretsManagerRDSPostgreSQLRotationSingleUser" application for the current partition.
+func testAccAwsServerlessApplicationRepositoryCloudFormationApplicationID() string {
+	arnRegion := endpoints.UsEast1RegionID
+	arnAccountID := "297356227824"
+
+	if testAccGetPartition() == endpoints.AwsUsGovPartitionID {
+		arnRegion = endpoints.UsGovWest1RegionID
+		arnAccountID = "023102451235"
+	}
+
+	return arn.ARN{
+		Partition: testAccGetPartition(),
+		Service:   serverlessrepository.ServiceName,
+		Region:    arnRegion,
+		AccountID: arnAccountID,
+		Resource:  "applications/SecretsManagerRDSPostgreSQLRotationSingleUser",
+	}.String()
+}
+
+func testAccAWSServerlessApplicationRepositoryCloudFormationStackConfig(stackName, appARN string) string {
+	return fmt.Sprintf(`
+resource "aws_serverlessapplicationrepository_cloudformation_stack" "postgres-rotator" {
+  name           = "%[1]s"
+  application_id = "%[2]s"
+
+  capabilities = [
+    "CAPABILITY_IAM",
+    "CAPABILITY_RESOURCE_POLICY",
+  ]
+
+  parameters = {
+    functionName = "func-%[1]s"
+    endpoint     = "secretsmanager.${data.aws_region.current.name}.${data.aws_partition.current.dns_suffix}"
+  }
+}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+`, stackName, appARN)
+}
+
+func testAccAWSServerlessApplicationRepositoryCloudFormationStackConfigVersioned(stackName, appARN, version string) string {
+	return fmt.Sprintf(`
+resource "aws_serverlessapplicationrepository_cloudformation_stack" "postgres-rotator" {
+  name             = "%[1]s"
+  application_id   = "%[2]s"
+  semantic_version = "%[3]s"
+
+  capabilities = [
+    "CAPABILITY_IAM",
+  ]
+
+  parameters = {
+    functionName = "func-%[1]s"
+    endpoint     = "secretsmanager.${data.aws_region.current.name}.${data.aws_partition.current.dns_suffix}"
+  }
+}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+`, stackName, appARN, version)
+}
+
+func testAccAWSServerlessApplicationRepositoryCloudFormationStackConfigVersioned2(stackName, appARN, version string) string {
+	return fmt.Sprintf(`
+resource "aws_serverlessapplicationrepository_cloudformation_stack" "postgres-rotator" {
+  name             = "%[1]s"
+  application_id   = "%[2]s"
+  semantic_version = "%[3]s"
+
+  capabilities = [
+    "CAPABILITY_IAM",
+    "CAPABILITY_RESOURCE_POLICY",
+  ]
+
+  parameters = {
+    functionName = "func-%[1]s"
+    endpoint     = "secretsmanager.${data.aws_region.current.name}.${data.aws_partition.current.dns_suffix}"
+  }
+}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+`, stackName, appARN, version)
+}
+
+func testAccAWSServerlessApplicationRepositoryCloudFormationStackConfigTags1(stackName, appARN, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_serverlessapplicationrepository_cloudformation_stack" "postgres-rotator" {
+  name           = "%[1]s"
+  application_id = "%[2]s"
+
+  capabilities = [
+    "CAPABILITY_IAM",
+    "CAPABILITY_RESOURCE_POLICY",
+  ]
+
+  parameters = {
+    functionName = "func-%[1]s"
+    endpoint     = "secretsmanager.${data.aws_region.current.name}.${data.aws_partition.current.dns_suffix}"
+  }
+
+  tags = {
+    %[3]q = %[4]q
+  }
+}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+`, stackName, appARN, tagKey1, tagValue1)
+}
+
+func testAccAWSServerlessApplicationRepositoryCloudFormationStackConfigTags2(stackName, appARN, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_serverlessapplicationrepository_cloudformation_stack" "postgres-rotator" {
+  name           = "%[1]s"
+  application_id = "%[2]s"
+
+  capabilities = [
+    "CAPABILITY_IAM",
+    "CAPABILITY_RESOURCE_POLICY",
+  ]
+
+  parameters = {
+    functionName = "func-%[1]s"
+    endpoint     = "secretsmanager.${data.aws_region.current.name}.${data.aws_partition.current.dns_suffix}"
+  }
+
+  tags = {
+    %[3]q = %[4]q
+    %[5]q = %[6]q
+  }
+}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+`, stackName, appARN, tagKey1, tagValue1, tagKey2, tagValue2)
+}
+
+func testAccAWSServerlessApplicationRepositoryCloudFormationStackConfigUpdateInitial(stackName, appARN, functionName string) string {
+	return fmt.Sprintf(`
+resource "aws_serverlessapplicationrepository_cloudformation_stack" "postgres-rotator" {
+  name           = "%[1]s"
+  application_id = "%[2]s"
+
+  capabilities = [
+    "CAPABILITY_IAM",
+    "CAPABILITY_RESOURCE_POLICY",
+  ]
+
+  parameters = {
+    functionName = "%[3]s"
+    endpoint     = "secretsmanager.${data.aws_region.current.name}.${data.aws_partition.current.dns_suffix}"
+  }
+
+  tags = {
+    ToUpdate    = "InitialValue"
+    InitialOnly = "Initial"
+  }
+}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+`, stackName, appARN, functionName)
+}
+
+func testAccAWSServerlessApplicationRepositoryCloudFormationStackConfigUpdated(stackName, appARN, functionName string) string {
+	return fmt.Sprintf(`
+resource "aws_serverlessapplicationrepository_cloudformation_stack" "postgres-rotator" {
+  name           = "%[1]s"
+  application_id = "%[2]s"
+
+  capabilities = [
+    "CAPABILITY_IAM",
+    "CAPABILITY_RESOURCE_POLICY",
+  ]
+
+  parameters = {
+    functionName = "%[3]s"
+    endpoint     = "secretsmanager.${data.aws_region.current.name}.${data.aws_partition.current.dns_suffix}"
+  }
+
+  tags = {
+    ToUpdate    = "UpdatedValue"
+    UpdatedOnly = "Updated"
+  }
+}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+`, stackName, appARN, functionName)
+}
@@ -74,6 +74,34 @@ func validateTypeStringNullableFloat(v interface{}, k string) (ws []string, es [
 	return
 }
 
+// validateTypeStringNullableIntAtLeast provides custom error messaging for TypeString ints
+// Some arguments require an integer value or an unspecified, empty field.
+func validateTypeStringNullableIntAtLeast(min int) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, es []error) {
+		value, ok := v.(string)
+		if !ok {
+			es = append(es, fmt.Errorf("expected type of %s to be string", k))
+			return
+		}
+
+		if value == "" {
+			return
+		}
+
+		i, err := strconv.Atoi(value)
+		if err != nil {
+			es = append(es, fmt.Errorf("%s: cannot parse '%s' as int: %s", k, value, err))
+			return
+		}
+
+		if i < min {
+			es = append(es, fmt.Errorf("expected %s to be at least (%d), got %d", k, min, i))
+		}
+
+		return
+	}
+}
+
 func validateTransferServerID(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 
@@ -544,6 +572,24 @@ func validateCloudWatchEventRuleName(v interface{}, k string) (ws []string, erro
 	return
 }
 
+func validateCloudWatchEventBusName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) < 1 || len(value) > 256 {
+		errors = append(errors, fmt.Errorf(
+			"%q must be between 1 and 256 characters: %q", k, value))
+	}
+
+	// http://docs.aws.amazon.com/AmazonCloudWatchEvents/latest/APIReference/API_CreateEventBus.html
+	pattern := `^[/\.\-_A-Za-z0-9]+$`
+	if !regexp.MustCompile(pattern).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q doesn't comply with restrictions (%q): %q",
+			k, pattern, value))
+	}
+
+	return
+}
+
 func validateCloudWatchLogResourcePolicyDocument(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 	// http://docs.aws.amazon.com/AmazonCloudWatchLogs/latest/APIReference/API_PutResourcePolicy.html
@@ -654,6 +700,27 @@ func validateAwsAccountId(v interface{}, k string) (ws []string, errors []error)
 	return
 }
 
+// validateLakeFormationPrincipal validates a Lake Formation principal,
+// which may be an ARN, an AWS account ID or IAM_ALLOWED_PRINCIPALS.
+func validateLakeFormationPrincipal(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if value == "IAM_ALLOWED_PRINCIPALS" {
+		return
+	}
+
+	if regexp.MustCompile(`^\d{12}$`).MatchString(value) {
+		return
+	}
+
+	if arn.IsARN(value) {
+		return validateArn(v, k)
+	}
+
+	errors = append(errors, fmt.Errorf("%q (%s) must be an ARN, an AWS account ID or IAM_ALLOWED_PRINCIPALS", k, value))
+	return
+}
+
 func validateArn(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 
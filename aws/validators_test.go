@@ -115,6 +115,92 @@ func TestValidateTypeStringNullableFloat(t *testing.T) {
 	}
 }
 
+func TestValidateTypeStringNullableIntAtLeast(t *testing.T) {
+	testCases := []struct {
+		val         interface{}
+		expectedErr *regexp.Regexp
+	}{
+		{
+			val: "",
+		},
+		{
+			val: "0",
+		},
+		{
+			val: "300",
+		},
+		{
+			val:         "-1",
+			expectedErr: regexp.MustCompile(`to be at least`),
+		},
+		{
+			val:         "42.0",
+			expectedErr: regexp.MustCompile(`cannot parse`),
+		},
+		{
+			val:         "threeve",
+			expectedErr: regexp.MustCompile(`cannot parse`),
+		},
+	}
+
+	matchErr := func(errs []error, r *regexp.Regexp) bool {
+		// err must match one provided
+		for _, err := range errs {
+			if r.MatchString(err.Error()) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	for i, tc := range testCases {
+		_, errs := validateTypeStringNullableIntAtLeast(0)(tc.val, "test_property")
+
+		if len(errs) == 0 && tc.expectedErr == nil {
+			continue
+		}
+
+		if len(errs) != 0 && tc.expectedErr == nil {
+			t.Fatalf("expected test case %d to produce no errors, got %v", i, errs)
+		}
+
+		if !matchErr(errs, tc.expectedErr) {
+			t.Fatalf("expected test case %d to produce error matching \"%s\", got %v", i, tc.expectedErr, errs)
+		}
+	}
+}
+
+func TestValidateLakeFormationPrincipal(t *testing.T) {
+	validNames := []string{
+		"IAM_ALLOWED_PRINCIPALS",
+		"123456789012",
+		"arn:aws:iam::123456789012:role/example",
+		"arn:aws:iam::123456789012:user/example",
+		"arn:aws-us-gov:iam::123456789012:role/example",
+	}
+	for _, v := range validNames {
+		_, errors := validateLakeFormationPrincipal(v, "principal")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Lake Formation principal: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"iam_allowed_principals",
+		"1234567890",
+		"example",
+		"arn:aws:iam::1234:role/example",
+	}
+	for _, v := range invalidNames {
+		_, errors := validateLakeFormationPrincipal(v, "principal")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Lake Formation principal", v)
+		}
+	}
+}
+
 func TestValidateCloudWatchDashboardName(t *testing.T) {
 	validNames := []string{
 		"HelloWorl_d",
@@ -169,6 +255,33 @@ func TestValidateCloudWatchEventRuleName(t *testing.T) {
 	}
 }
 
+func TestValidateCloudWatchEventBusName(t *testing.T) {
+	validNames := []string{
+		"HelloWorl_d",
+		"hello-world",
+		"hello.World0125",
+		"aws.partner/mongodb.com/stitch.trigger/something",
+	}
+	for _, v := range validNames {
+		_, errors := validateCloudWatchEventBusName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid CW event bus name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"special@character",
+		"TooLoooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooongName",
+	}
+	for _, v := range invalidNames {
+		_, errors := validateCloudWatchEventBusName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid CW event bus name", v)
+		}
+	}
+}
+
 func TestValidateLambdaFunctionName(t *testing.T) {
 	validNames := []string{
 		"arn:aws:lambda:us-west-2:123456789012:function:ThumbNail",